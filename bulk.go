@@ -0,0 +1,133 @@
+package splitwise
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const defaultBulkConcurrency = 4
+
+// BulkSummary reports the outcome of a bulk operation.
+type BulkSummary struct {
+	Total     int
+	Succeeded int
+	Failed    int
+	// Skipped counts the items which were never sent because the context was
+	// cancelled first.
+	Skipped int
+	Elapsed time.Duration
+}
+
+func (s BulkSummary) String() string {
+	return fmt.Sprintf(
+		"%d items: %d succeeded, %d failed, %d skipped in %s",
+		s.Total, s.Succeeded, s.Failed, s.Skipped, s.Elapsed,
+	)
+}
+
+// CreateExpenseResult is the result of creating a single expense in BulkCreateExpenses.
+type CreateExpenseResult struct {
+	Expense *Expense
+	Err     error
+}
+
+// BulkCreateExpenses creates expenses using up to concurrency requests at a time.
+//
+// The results are in the same order as reqs. A failure to create one expense does not
+// prevent the others from being created. Once ctx is cancelled no further requests are
+// sent, and the remaining items fail with the error of the context.
+//
+// A concurrency of zero or less uses a default of 4.
+func (c *Client) BulkCreateExpenses(ctx context.Context, reqs []CreateExpenseRequest, concurrency int) ([]CreateExpenseResult, BulkSummary) {
+	results := make([]CreateExpenseResult, len(reqs))
+	summary := runBulk(ctx, len(reqs), concurrency, func(ctx context.Context, i int) error {
+		expense, err := c.CreateExpense(ctx, reqs[i])
+		results[i] = CreateExpenseResult{Expense: expense, Err: err}
+		return err
+	}, func(i int, err error) {
+		results[i].Err = err
+	})
+	return results, summary
+}
+
+// BulkDeleteExpenses deletes expenses using up to concurrency requests at a time.
+//
+// The returned errors are in the same order as ids, and are nil for each expense which
+// was deleted. Cancellation behaves as in BulkCreateExpenses.
+func (c *Client) BulkDeleteExpenses(ctx context.Context, ids []int, concurrency int) ([]error, BulkSummary) {
+	errs := make([]error, len(ids))
+	summary := runBulk(ctx, len(ids), concurrency, func(ctx context.Context, i int) error {
+		errs[i] = c.DeleteExpense(ctx, ids[i])
+		return errs[i]
+	}, func(i int, err error) {
+		errs[i] = err
+	})
+	return errs, summary
+}
+
+// BulkAddUsersToGroup adds users to a group using up to concurrency requests at a time.
+//
+// The returned errors are in the same order as users, and are nil for each user which
+// was added. Cancellation behaves as in BulkCreateExpenses.
+func (c *Client) BulkAddUsersToGroup(ctx context.Context, groupID int, users []UserOption, concurrency int) ([]error, BulkSummary) {
+	errs := make([]error, len(users))
+	summary := runBulk(ctx, len(users), concurrency, func(ctx context.Context, i int) error {
+		errs[i] = c.AddUserToGroup(ctx, groupID, users[i])
+		return errs[i]
+	}, func(i int, err error) {
+		errs[i] = err
+	})
+	return errs, summary
+}
+
+// runBulk calls do for each of n items using a bounded number of workers.
+//
+// Items which are not dispatched before ctx is cancelled are passed to skip instead.
+func runBulk(
+	ctx context.Context,
+	n int,
+	concurrency int,
+	do func(ctx context.Context, i int) error,
+	skip func(i int, err error),
+) BulkSummary {
+	if concurrency <= 0 {
+		concurrency = defaultBulkConcurrency
+	}
+	start := time.Now()
+	summary := BulkSummary{Total: n}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i := 0; i < n; i++ {
+		select {
+		case <-ctx.Done():
+		case sem <- struct{}{}:
+		}
+		if ctx.Err() != nil {
+			for j := i; j < n; j++ {
+				skip(j, ctx.Err())
+			}
+			summary.Skipped = n - i
+			break
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			err := do(ctx, i)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				summary.Failed++
+			} else {
+				summary.Succeeded++
+			}
+		}(i)
+	}
+	wg.Wait()
+	summary.Elapsed = time.Since(start)
+	return summary
+}
@@ -0,0 +1,79 @@
+package splitwise
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBulkCreateExpenses(t *testing.T) {
+	var inFlight, maxInFlight int32
+	client, _ := newCountingClient(t, func(rw http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		r.ParseForm()
+		if strings.HasPrefix(r.PostForm.Get("description"), "bad") {
+			rw.Write([]byte(`{"errors": {"cost": ["is invalid"]}}`))
+			return
+		}
+		rw.Write([]byte(`{"expense": {"id": 1, "description": "` + r.PostForm.Get("description") + `"}}`))
+	})
+
+	reqs := make([]CreateExpenseRequest, 10)
+	for i := range reqs {
+		reqs[i] = CreateExpenseRequest{Cost: "1.00", Description: "ok", SplitStrategy: SplitEqually(1)}
+	}
+	reqs[3].Description = "bad"
+	reqs[7].Description = "bad"
+
+	results, summary := client.BulkCreateExpenses(context.Background(), reqs, 3)
+	if summary.Total != 10 || summary.Succeeded != 8 || summary.Failed != 2 || summary.Skipped != 0 {
+		t.Errorf("unexpected summary: %s", summary)
+	}
+	for i, res := range results {
+		failed := i == 3 || i == 7
+		var apiErr *APIError
+		if failed != errors.As(res.Err, &apiErr) {
+			t.Errorf("item %d: unexpected error %v", i, res.Err)
+		}
+		if !failed && res.Expense.Description != "ok" {
+			t.Errorf("item %d: unexpected expense %+v", i, res.Expense)
+		}
+	}
+	if max := atomic.LoadInt32(&maxInFlight); max > 3 {
+		t.Errorf("expected at most 3 concurrent requests, got %d", max)
+	}
+}
+
+func TestBulkCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls int32
+	client, _ := newCountingClient(t, func(rw http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 2 {
+			cancel()
+		}
+		rw.Write([]byte(`{"success": true}`))
+	})
+
+	errs, summary := client.BulkDeleteExpenses(ctx, []int{1, 2, 3, 4, 5, 6}, 1)
+	if summary.Skipped == 0 || summary.Succeeded+summary.Failed+summary.Skipped != 6 {
+		t.Fatalf("expected remaining items to be skipped: %s", summary)
+	}
+	if !errors.Is(errs[5], context.Canceled) {
+		t.Errorf("expected the last item to be cancelled, got %v", errs[5])
+	}
+	if errs[0] != nil {
+		t.Errorf("expected the first item to succeed, got %v", errs[0])
+	}
+}
@@ -0,0 +1,322 @@
+package splitwise
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CacheEntry is a cached API response.
+type CacheEntry struct {
+	Body []byte
+	// ETag is the entity tag of the response, if the server provided one.
+	ETag    string
+	Expires time.Time
+}
+
+// Cache stores API responses for WithCache.
+//
+// Expired entries should be retained until they are replaced or deleted, since they
+// may still be revalidated using their ETag.
+type Cache interface {
+	Get(key string) (*CacheEntry, bool)
+	Set(key string, entry *CacheEntry)
+	Delete(key string)
+}
+
+type memoryCache struct {
+	mu      sync.Mutex
+	entries map[string]*CacheEntry
+}
+
+// NewMemoryCache returns a Cache which holds entries in memory.
+func NewMemoryCache() Cache {
+	return &memoryCache{
+		entries: make(map[string]*CacheEntry),
+	}
+}
+
+func (mc *memoryCache) Get(key string) (*CacheEntry, bool) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	entry, ok := mc.entries[key]
+	return entry, ok
+}
+
+func (mc *memoryCache) Set(key string, entry *CacheEntry) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	mc.entries[key] = entry
+}
+
+func (mc *memoryCache) Delete(key string) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	delete(mc.entries, key)
+}
+
+// Invalidation returns the cache keys made stale by a successful operation.
+//
+// Cache keys are the path of the cached operation, e.g. "get_group/123".
+type Invalidation func(op *Operation) []string
+
+// defaultCacheTTLs are the operations cached by WithCache unless configured otherwise.
+//
+// Groups are not cached by default, since their balances change with every expense
+// and not every expense write identifies the group it affects.
+var defaultCacheTTLs = map[string]time.Duration{
+	"get_categories":   24 * time.Hour,
+	"get_currencies":   24 * time.Hour,
+	"get_current_user": 10 * time.Minute,
+	"get_user":         10 * time.Minute,
+}
+
+// DefaultCacheTTLs returns the operations cached by WithCache unless configured
+// otherwise, and how long for. The map is a copy, so it may be modified to build a
+// CacheConfig.
+func DefaultCacheTTLs() map[string]time.Duration {
+	ttls := make(map[string]time.Duration, len(defaultCacheTTLs))
+	for name, ttl := range defaultCacheTTLs {
+		ttls[name] = ttl
+	}
+	return ttls
+}
+
+// DefaultCacheInvalidations returns the invalidations applied by WithCache unless
+// configured otherwise. The map is a copy, so it may be modified to build a
+// CacheConfig.
+//
+// When caching get_group, note that deleting or restoring an expense, or creating one
+// without a group ID, cannot invalidate the group it belongs to, whose balances may be
+// stale until the entry expires.
+func DefaultCacheInvalidations() map[string]Invalidation {
+	invalidations := make(map[string]Invalidation, len(defaultCacheInvalidations))
+	for name, inv := range defaultCacheInvalidations {
+		invalidations[name] = inv
+	}
+	return invalidations
+}
+
+var defaultCacheInvalidations = map[string]Invalidation{
+	"create_group":           invalidate("get_groups"),
+	"delete_group":           invalidate("get_groups", "get_group/{id}"),
+	"undelete_group":         invalidate("get_groups", "get_group/{id}"),
+	"add_user_to_group":      invalidate("get_groups", "get_group/{group_id}"),
+	"remove_user_from_group": invalidate("get_groups", "get_group/{group_id}"),
+	"create_expense":         invalidate("get_groups", "get_group/{group_id}"),
+	"delete_expense":         invalidate("get_groups"),
+	"undelete_expense":       invalidate("get_groups"),
+}
+
+// invalidate returns an Invalidation for the given key templates.
+//
+// A template may reference "{id}", the ID in the path of the operation, or any
+// request value such as "{group_id}". Templates whose references cannot be
+// resolved are skipped.
+func invalidate(templates ...string) Invalidation {
+	return func(op *Operation) []string {
+		var keys []string
+		for _, tmpl := range templates {
+			key, ok := expandKey(tmpl, op)
+			if ok {
+				keys = append(keys, key)
+			}
+		}
+		return keys
+	}
+}
+
+func expandKey(tmpl string, op *Operation) (string, bool) {
+	start := strings.IndexByte(tmpl, '{')
+	if start < 0 {
+		return tmpl, true
+	}
+	end := strings.IndexByte(tmpl, '}')
+	name := tmpl[start+1 : end]
+	var value string
+	if name == "id" {
+		i := strings.LastIndexByte(op.Path, '/')
+		if i < 0 {
+			return "", false
+		}
+		value = op.Path[i+1:]
+	} else {
+		value = op.Values.Get(name)
+	}
+	if value == "" {
+		return "", false
+	}
+	return tmpl[:start] + value + tmpl[end+1:], true
+}
+
+// CacheConfig controls what is cached by WithCache.
+type CacheConfig struct {
+	// TTL is how long to cache each operation for. If nil, DefaultCacheTTLs is used.
+	TTL map[string]time.Duration
+	// Invalidate lists the invalidations to apply after each mutation. If nil,
+	// DefaultCacheInvalidations is used.
+	Invalidate map[string]Invalidation
+	// Namespace is prepended to every cache key. Responses such as get_current_user
+	// depend on the user a client is authenticated as, so clients for different users
+	// sharing a Cache must each use a different Namespace, e.g. their user ID.
+	Namespace string
+}
+
+// WithCache caches responses to slow-changing resources such as GetCategories.
+//
+// Concurrent identical reads are deduplicated into a single request, and expired
+// entries are revalidated with If-None-Match when the server provided an ETag.
+//
+// A nil config uses DefaultCacheTTLs and DefaultCacheInvalidations. The config is
+// read once, when the middleware is created.
+//
+// Cache keys do not identify the user, so a Cache must not be shared by clients for
+// different users unless each sets CacheConfig.Namespace.
+func WithCache(cache Cache, config *CacheConfig) Option {
+	return WithMiddleware(CacheMiddleware(cache, config))
+}
+
+// CacheMiddleware returns the Middleware used by WithCache.
+func CacheMiddleware(cache Cache, config *CacheConfig) Middleware {
+	cm := &cacheMiddleware{
+		cache:      cache,
+		ttl:        DefaultCacheTTLs(),
+		invalidate: DefaultCacheInvalidations(),
+		flights:    make(map[string]*flight),
+	}
+	if config != nil {
+		if config.TTL != nil {
+			cm.ttl = make(map[string]time.Duration, len(config.TTL))
+			for name, ttl := range config.TTL {
+				cm.ttl[name] = ttl
+			}
+		}
+		if config.Invalidate != nil {
+			cm.invalidate = make(map[string]Invalidation, len(config.Invalidate))
+			for name, inv := range config.Invalidate {
+				cm.invalidate[name] = inv
+			}
+		}
+		cm.namespace = config.Namespace
+	}
+	return func(next Handler) Handler {
+		return func(ctx context.Context, op *Operation) error {
+			if ttl, ok := cm.ttl[op.Name]; ok && op.Method == http.MethodGet {
+				return cm.read(ctx, next, op, ttl)
+			}
+			if err := next(ctx, op); err != nil {
+				return err
+			}
+			if inv, ok := cm.invalidate[op.Name]; ok {
+				for _, key := range inv(op) {
+					cm.cache.Delete(cm.namespace + key)
+				}
+			}
+			return nil
+		}
+	}
+}
+
+// timeNow is replaced in tests.
+var timeNow = time.Now
+
+type cacheMiddleware struct {
+	cache      Cache
+	ttl        map[string]time.Duration
+	invalidate map[string]Invalidation
+	namespace  string
+
+	mu      sync.Mutex
+	flights map[string]*flight
+}
+
+// flight is a read in progress, shared by all concurrent callers for the same key.
+type flight struct {
+	done chan struct{}
+	raw  []byte
+	err  error
+}
+
+func (cm *cacheMiddleware) read(ctx context.Context, next Handler, op *Operation, ttl time.Duration) error {
+	key := cm.namespace + op.Path
+	if len(op.Query) > 0 {
+		key = fmt.Sprintf("%s?%s", key, op.Query.Encode())
+	}
+	for {
+		entry, ok := cm.cache.Get(key)
+		if ok && timeNow().Before(entry.Expires) {
+			op.StatusCode = http.StatusOK
+			op.RawResponse = entry.Body
+			return op.decode()
+		}
+
+		cm.mu.Lock()
+		if f, ok := cm.flights[key]; ok {
+			cm.mu.Unlock()
+			select {
+			case <-f.done:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			if f.err != nil {
+				// The error may be particular to the caller that made the request,
+				// such as its context being cancelled, so try again.
+				continue
+			}
+			op.StatusCode = http.StatusOK
+			op.RawResponse = f.raw
+			return op.decode()
+		}
+		f := &flight{done: make(chan struct{})}
+		cm.flights[key] = f
+		cm.mu.Unlock()
+
+		f.raw, f.err = cm.fetch(ctx, next, op, key, entry, ttl)
+
+		cm.mu.Lock()
+		delete(cm.flights, key)
+		cm.mu.Unlock()
+		close(f.done)
+		return f.err
+	}
+}
+
+func (cm *cacheMiddleware) fetch(
+	ctx context.Context,
+	next Handler,
+	op *Operation,
+	key string,
+	stale *CacheEntry,
+	ttl time.Duration,
+) ([]byte, error) {
+	if stale != nil && stale.ETag != "" {
+		if op.Header == nil {
+			op.Header = make(http.Header)
+		}
+		op.Header.Set("If-None-Match", stale.ETag)
+	}
+	err := next(ctx, op)
+	if stale != nil && errors.Is(err, UnexpectedStatus{Status: http.StatusNotModified}) {
+		cm.cache.Set(key, &CacheEntry{
+			Body:    stale.Body,
+			ETag:    stale.ETag,
+			Expires: timeNow().Add(ttl),
+		})
+		op.StatusCode = http.StatusOK
+		op.RawResponse = stale.Body
+		return stale.Body, op.decode()
+	}
+	if err != nil {
+		return nil, err
+	}
+	cm.cache.Set(key, &CacheEntry{
+		Body:    op.RawResponse,
+		ETag:    op.ResponseHeader.Get("ETag"),
+		Expires: timeNow().Add(ttl),
+	})
+	return op.RawResponse, nil
+}
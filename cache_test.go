@@ -0,0 +1,253 @@
+package splitwise
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newCountingClient returns a client whose requests are counted by path.
+func newCountingClient(t *testing.T, handler http.HandlerFunc, opts ...Option) (*Client, map[string]int) {
+	var mu sync.Mutex
+	counts := make(map[string]int)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		counts[r.URL.Path]++
+		mu.Unlock()
+		handler(rw, r)
+	}))
+	t.Cleanup(server.Close)
+	u, _ := url.Parse(server.URL)
+	return NewClient(&testHTTPClient{u: u}, opts...), counts
+}
+
+func TestCacheServesFreshEntries(t *testing.T) {
+	client, counts := newCountingClient(t, func(rw http.ResponseWriter, r *http.Request) {
+		rw.Write([]byte(`{"categories": [{"id": 1, "name": "Utilities"}]}`))
+	}, WithCache(NewMemoryCache(), nil))
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		res, err := client.GetCategories(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if len(res.Categories) != 1 || res.Categories[0].Name != "Utilities" {
+			t.Fatalf("unexpected response: %+v", res)
+		}
+	}
+	if n := counts["/api/v3.0/get_categories"]; n != 1 {
+		t.Fatalf("expected 1 request, got %d", n)
+	}
+}
+
+func TestCacheRevalidatesWithETag(t *testing.T) {
+	now := time.Now()
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	var revalidated int
+	client, counts := newCountingClient(t, func(rw http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			revalidated++
+			rw.WriteHeader(http.StatusNotModified)
+			return
+		}
+		rw.Header().Set("ETag", `"v1"`)
+		rw.Write([]byte(`{"user": {"id": 1, "first_name": "Ada"}}`))
+	}, WithCache(NewMemoryCache(), nil))
+
+	ctx := context.Background()
+	if _, err := client.GetCurrentUser(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	now = now.Add(time.Hour)
+	user, err := client.GetCurrentUser(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if user.FirstName != "Ada" {
+		t.Errorf("expected the cached user, got %+v", user)
+	}
+	if revalidated != 1 || counts["/api/v3.0/get_current_user"] != 2 {
+		t.Errorf("expected a single revalidation, got %d (%d requests)", revalidated, counts["/api/v3.0/get_current_user"])
+	}
+}
+
+func TestCacheInvalidation(t *testing.T) {
+	client, counts := newCountingClient(t, func(rw http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3.0/get_group/5":
+			rw.Write([]byte(`{"group": {"id": 5}}`))
+		default:
+			rw.Write([]byte(`{"success": true}`))
+		}
+	}, WithCache(NewMemoryCache(), &CacheConfig{
+		TTL: map[string]time.Duration{"get_group": time.Minute},
+	}))
+
+	ctx := context.Background()
+	client.GetGroup(ctx, 5)
+	client.GetGroup(ctx, 5)
+	if err := client.AddUserToGroup(ctx, 5, ExistingUser(1)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	client.GetGroup(ctx, 5)
+	if n := counts["/api/v3.0/get_group/5"]; n != 2 {
+		t.Fatalf("expected 2 requests, got %d", n)
+	}
+}
+
+func TestCacheSkipsGroupsByDefault(t *testing.T) {
+	client, counts := newCountingClient(t, func(rw http.ResponseWriter, r *http.Request) {
+		rw.Write([]byte(`{"group": {"id": 5}}`))
+	}, WithCache(NewMemoryCache(), nil))
+
+	ctx := context.Background()
+	client.GetGroup(ctx, 5)
+	client.GetGroup(ctx, 5)
+	if n := counts["/api/v3.0/get_group/5"]; n != 2 {
+		t.Fatalf("expected groups not to be cached, got %d requests", n)
+	}
+}
+
+func TestCacheDeduplicatesConcurrentReads(t *testing.T) {
+	release := make(chan struct{})
+	var inFlight int32
+	client, counts := newCountingClient(t, func(rw http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&inFlight, 1)
+		<-release
+		rw.Write([]byte(`{"user": {"id": 2}}`))
+	}, WithCache(NewMemoryCache(), nil))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			user, err := client.GetUser(context.Background(), 2)
+			if err != nil || user.ID != 2 {
+				t.Errorf("unexpected result %+v, %v", user, err)
+			}
+		}()
+	}
+	for atomic.LoadInt32(&inFlight) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	// Give the remaining callers a chance to join the read in progress.
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	if n := counts["/api/v3.0/get_user/2"]; n != 1 {
+		t.Fatalf("expected 1 request, got %d", n)
+	}
+}
+
+func TestDefaultCacheTTLsCopy(t *testing.T) {
+	DefaultCacheTTLs()["get_group"] = time.Hour
+	client, counts := newCountingClient(t, func(rw http.ResponseWriter, r *http.Request) {
+		rw.Write([]byte(`{"group": {"id": 5}}`))
+	}, WithCache(NewMemoryCache(), nil))
+
+	ctx := context.Background()
+	client.GetGroup(ctx, 5)
+	client.GetGroup(ctx, 5)
+	if n := counts["/api/v3.0/get_group/5"]; n != 2 {
+		t.Fatalf("expected the defaults to be unchanged, got %d requests", n)
+	}
+}
+
+func TestCacheNamespace(t *testing.T) {
+	cache := NewMemoryCache()
+	newClient := func(id int) (*Client, map[string]int) {
+		return newCountingClient(t, func(rw http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(rw, `{"user": {"id": %d}}`, id)
+		}, WithCache(cache, &CacheConfig{Namespace: fmt.Sprintf("user/%d/", id)}))
+	}
+	ada, _ := newClient(1)
+	grace, counts := newClient(2)
+
+	ctx := context.Background()
+	if user, err := ada.GetCurrentUser(ctx); err != nil || user.ID != 1 {
+		t.Fatalf("unexpected user %+v, %v", user, err)
+	}
+	for i := 0; i < 2; i++ {
+		if user, err := grace.GetCurrentUser(ctx); err != nil || user.ID != 2 {
+			t.Fatalf("expected each namespace to be cached separately, got %+v, %v", user, err)
+		}
+	}
+	if n := counts["/api/v3.0/get_current_user"]; n != 1 {
+		t.Errorf("expected 1 request, got %d", n)
+	}
+}
+
+func TestCacheStatusCode(t *testing.T) {
+	var statuses []int
+	record := func(next Handler) Handler {
+		return func(ctx context.Context, op *Operation) error {
+			err := next(ctx, op)
+			statuses = append(statuses, op.StatusCode)
+			return err
+		}
+	}
+	client, _ := newCountingClient(t, func(rw http.ResponseWriter, r *http.Request) {
+		rw.Write([]byte(`{"categories": []}`))
+	}, WithMiddleware(record), WithCache(NewMemoryCache(), nil))
+
+	ctx := context.Background()
+	client.GetCategories(ctx)
+	client.GetCategories(ctx)
+	if len(statuses) != 2 || statuses[0] != http.StatusOK || statuses[1] != http.StatusOK {
+		t.Errorf("expected cached responses to have status 200, got %v", statuses)
+	}
+}
+
+func TestCacheFollowerRetriesAfterLeaderCancelled(t *testing.T) {
+	release := make(chan struct{})
+	var requests int32
+	client, _ := newCountingClient(t, func(rw http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			select {
+			case <-release:
+			case <-r.Context().Done():
+			}
+			return
+		}
+		rw.Write([]byte(`{"user": {"id": 2}}`))
+	}, WithCache(NewMemoryCache(), nil))
+
+	leaderCtx, cancel := context.WithCancel(context.Background())
+	defer close(release)
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := client.GetUser(leaderCtx, 2)
+		leaderErr <- err
+	}()
+	for atomic.LoadInt32(&requests) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	followerErr := make(chan error, 1)
+	go func() {
+		user, err := client.GetUser(context.Background(), 2)
+		if err == nil && user.ID != 2 {
+			t.Errorf("unexpected user %+v", user)
+		}
+		followerErr <- err
+	}()
+	// Give the follower a chance to join the read in progress.
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+
+	if err := <-leaderErr; err == nil {
+		t.Error("expected the cancelled caller to fail")
+	}
+	if err := <-followerErr; err != nil {
+		t.Errorf("expected the follower to retry, got %s", err)
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"path"
@@ -27,6 +29,11 @@ var (
 // Client to the splitwise API.
 type Client struct {
 	HTTPClient
+
+	baseURL              *url.URL
+	jsonRequests         bool
+	middleware           []Middleware
+	reportDecodeWarnings func(*Operation, []DecodeWarning)
 }
 
 type HTTPClient interface {
@@ -53,84 +60,38 @@ func (he UnexpectedStatus) Is(target error) bool {
 	return he.Status == other.Status
 }
 
-func NewClient(httpClient HTTPClient) *Client {
-	return &Client{httpClient}
-}
-
-type Registration int
-
-const (
-	RegistrationDummy Registration = iota
-	RegistrationConfirmed
-	RegistrationInvited
-)
-
-func (r Registration) String() string {
-	return []string{
-		"dummy",
-		"confirmed",
-		"invited",
-	}[r]
-}
+// Option configures a Client.
+type Option func(*Client)
 
-func (r *Registration) UnmarshalJSON(bytes []byte) error {
-	var name string
-	if err := json.Unmarshal(bytes, &name); err != nil {
-		return err
-	}
-	switch name {
-	case "dummy":
-		*r = RegistrationDummy
-	case "confirmed":
-		*r = RegistrationConfirmed
-	case "invited":
-		*r = RegistrationInvited
-	default:
-		return fmt.Errorf("unknown registration: '%s'", name)
+// WithBaseURL sends requests to the API at u rather than the splitwise API, e.g. to
+// use a local fake during development.
+func WithBaseURL(u *url.URL) Option {
+	return func(c *Client) {
+		c.baseURL = u
 	}
-	return nil
 }
 
-type RepeatInterval int
-
-const (
-	RepeatNever RepeatInterval = iota
-	RepeatWeekly
-	RepeatFortnightly
-	RepeatMonthly
-	RepeatYearly
-)
-
-func (ri RepeatInterval) String() string {
-	return []string{
-		"never",
-		"weekly",
-		"fortnightly",
-		"monthly",
-		"yearly",
-	}[ri]
+// WithJSONRequests sends request bodies as JSON rather than form encoded values.
+//
+// The body is built from the request when the operation is created, with the types of
+// its fields, and array values such as the users of an expense are sent as JSON arrays
+// of objects, so "users__0__user_id" becomes {"users": [{"user_id": 1}]}.
+//
+// Operation.Values still holds the flattened form values seen by middleware, but
+// changes made to them are not reflected in the JSON body. Operations not created by a
+// Client method, such as those passed directly to Execute, are sent form encoded.
+func WithJSONRequests() Option {
+	return func(c *Client) {
+		c.jsonRequests = true
+	}
 }
 
-func (ri *RepeatInterval) UnmarshalJSON(bytes []byte) error {
-	var name string
-	if err := json.Unmarshal(bytes, &name); err != nil {
-		return err
-	}
-	switch name {
-	case "never":
-		*ri = RepeatNever
-	case "weekly":
-		*ri = RepeatWeekly
-	case "fortnightly":
-		*ri = RepeatFortnightly
-	case "monthly":
-		*ri = RepeatYearly
-	case "yearly":
-		*ri = RepeatYearly
-	default:
-		return fmt.Errorf("unknown repeat interval: '%s'", name)
+func NewClient(httpClient HTTPClient, opts ...Option) *Client {
+	c := &Client{HTTPClient: httpClient}
+	for _, opt := range opts {
+		opt(c)
 	}
-	return nil
+	return c
 }
 
 type GetCategoriesResponse struct {
@@ -148,7 +109,14 @@ type Subcategory struct {
 	Name string `json:"name"`
 }
 
+type Currency struct {
+	CurrencyCode string `json:"currency_code"`
+	Unit         string `json:"unit"`
+}
+
 type User struct {
+	extraFields
+
 	ID           int          `json:"id"`
 	FirstName    string       `json:"first_name"`
 	LastName     string       `json:"last_name"`
@@ -200,6 +168,14 @@ func (c *Client) GetCategories(ctx context.Context) (*GetCategoriesResponse, err
 	return &res, err
 }
 
+func (c *Client) GetCurrencies(ctx context.Context) ([]Currency, error) {
+	var res struct {
+		Currencies []Currency `json:"currencies"`
+	}
+	err := c.get(ctx, "get_currencies", &res)
+	return res.Currencies, err
+}
+
 func (c *Client) GetCurrentUser(ctx context.Context) (*User, error) {
 	var res struct {
 		User User `json:"user"`
@@ -230,30 +206,65 @@ func (c *Client) do(
 	ctx context.Context,
 	method string,
 	u *url.URL,
-	apiRequest url.Values,
+	apiRequest *valueWriter,
 	apiResponse interface{},
 ) error {
-	u.Scheme = baseAPIURL.Scheme
-	u.Host = baseAPIURL.Host
-	u.Path = path.Join(baseAPIURL.Path, u.Path)
+	op := &Operation{
+		Name:   operationName(u.Path),
+		Method: method,
+		Path:   u.Path,
+		Query:  u.Query(),
+		Result: apiResponse,
+	}
+	if apiRequest != nil {
+		op.Values = apiRequest.Values
+		op.body = apiRequest.body
+	}
+	return c.Execute(ctx, op)
+}
+
+// send performs the HTTP request for op and decodes the response into op.Result.
+//
+// It is the innermost Handler of every middleware chain.
+func (c *Client) send(ctx context.Context, op *Operation) error {
+	base := c.baseURL
+	if base == nil {
+		base = baseAPIURL
+	}
+	u := &url.URL{
+		Scheme:   base.Scheme,
+		Host:     base.Host,
+		Path:     path.Join(base.Path, op.Path),
+		RawQuery: op.Query.Encode(),
+	}
 
 	var body io.Reader
 	var contentLength int
-	if apiRequest != nil {
-		encoded := []byte(apiRequest.Encode())
+	contentType := "application/x-www-form-urlencoded"
+	if op.Values != nil {
+		encoded := []byte(op.Values.Encode())
+		if c.jsonRequests && op.body != nil {
+			var err error
+			if encoded, err = json.Marshal(op.body); err != nil {
+				return fmt.Errorf("could not encode request: %s", err)
+			}
+			contentType = "application/json"
+		}
 		body = bytes.NewReader(encoded)
 		contentLength = len(encoded)
 	}
-	req, err := http.NewRequest(method, u.String(), body)
+	req, err := http.NewRequest(op.Method, u.String(), body)
 	if err != nil {
 		return fmt.Errorf("could not construct request: %s", err)
 	}
 	req = req.WithContext(ctx)
+	for key, values := range op.Header {
+		for _, v := range values {
+			req.Header.Add(key, v)
+		}
+	}
 	if body != nil {
-		// FIXME: Can JSON requests be used for everything
-		// instead of having to urlencode some of these payloads?
-
-		req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Add("Content-Type", contentType)
 		req.Header.Add("Content-Length", strconv.Itoa(contentLength))
 	}
 	client := c.HTTPClient
@@ -265,6 +276,8 @@ func (c *Client) do(
 		return fmt.Errorf("http request failed: %s", err)
 	}
 	defer res.Body.Close()
+	op.StatusCode = res.StatusCode
+	op.ResponseHeader = res.Header
 
 	switch res.StatusCode {
 	case 200:
@@ -276,9 +289,40 @@ func (c *Client) do(
 	default:
 		return UnexpectedStatus{res.StatusCode}
 	}
-	decoder := json.NewDecoder(res.Body)
-	if err := decoder.Decode(apiResponse); err != nil {
+	raw, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("read response: %s", err)
+	}
+	op.RawResponse = raw
+	return op.decode()
+}
+
+// decode unmarshals op.RawResponse into op.Result, surfacing any APIError it contains.
+//
+// With strict decoding, values of the wrong type or rejected by their type are
+// recorded in op.DecodeWarnings along with unknown fields instead of failing.
+func (op *Operation) decode() error {
+	data := op.RawResponse
+	if op.strict {
+		var err error
+		if data, err = op.checkStrict(); err != nil {
+			return fmt.Errorf("decode: %s", err)
+		}
+	}
+	err := json.Unmarshal(data, op.Result)
+	if op.strict {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			err = nil
+		}
+	}
+	if err != nil {
 		return fmt.Errorf("decode: %s", err)
 	}
+	if r, ok := op.Result.(apiErrorer); ok {
+		if err := r.apiError(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
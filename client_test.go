@@ -2,13 +2,16 @@ package splitwise
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
+	"reflect"
 	"strconv"
 	"testing"
 )
@@ -100,7 +103,7 @@ func TestClient(t *testing.T) {
 	}
 }
 
-func makeRequest(status int, responsePath string, useClient func(*Client, context.Context) error) (url.Values, error) {
+func makeRequest(status int, responsePath string, useClient func(*Client, context.Context) error, opts ...Option) (url.Values, error) {
 	var capturedValues url.Values
 	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
 		body, _ := ioutil.ReadAll(r.Body)
@@ -130,9 +133,7 @@ func makeRequest(status int, responsePath string, useClient func(*Client, contex
 	if err != nil {
 		return url.Values{}, err
 	}
-	client := &Client{
-		&testHTTPClient{u: u},
-	}
+	client := NewClient(&testHTTPClient{u: u}, opts...)
 	err = useClient(client, context.Background())
 	return capturedValues, err
 }
@@ -149,3 +150,205 @@ func (tc *testHTTPClient) Do(req *http.Request) (*http.Response, error) {
 }
 
 func stringPtr(val string) *string { return &val }
+
+func TestCreateExpenseInGroup(t *testing.T) {
+	groupID := 10
+	values, err := makeRequest(201, "fixtures/create_expense.json", func(client *Client, ctx context.Context) error {
+		_, err := client.CreateExpense(ctx, CreateExpenseRequest{
+			Cost:        "10.00",
+			Description: "test",
+			GroupID:     &groupID,
+			SplitStrategy: SplitManually(
+				UserShare{UserOption: ExistingUser(1), PaidShare: "10.00", OwedShare: "5.00"},
+				UserShare{UserOption: ExistingUser(2), PaidShare: "0.00", OwedShare: "5.00"},
+			),
+		})
+		return err
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if values.Get("group_id") != "10" || values.Get("users__1__user_id") != "2" {
+		t.Errorf("unexpected values: %v", values)
+	}
+}
+
+func TestGetExpensesFilters(t *testing.T) {
+	var queries []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		rw.Write([]byte(`{"expenses": []}`))
+	}))
+	defer server.Close()
+	u, _ := url.Parse(server.URL)
+	client := NewClient(&testHTTPClient{u: u})
+
+	groupID, friendID := 10, 20
+	ctx := context.Background()
+	for _, req := range []*GetExpensesRequest{
+		{GroupID: &groupID},
+		{FriendID: &friendID},
+		{},
+	} {
+		if _, err := client.GetExpenses(ctx, req); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if got := queries[0]; got.Get("group_id") != "10" || got.Get("friend_id") != "" {
+		t.Errorf("expected only the group to be filtered, got %v", got)
+	}
+	if got := queries[1]; got.Get("friend_id") != "20" || got.Get("group_id") != "" {
+		t.Errorf("expected only the friend to be filtered, got %v", got)
+	}
+	if got := queries[2]; got.Get("group_id") != "" || got.Get("friend_id") != "" {
+		t.Errorf("expected no filters, got %v", got)
+	}
+}
+
+// flattenJSON converts a JSON request body back into the equivalent form values.
+func flattenJSON(t *testing.T, body []byte) url.Values {
+	var obj map[string]interface{}
+	if err := json.Unmarshal(body, &obj); err != nil {
+		t.Fatalf("invalid JSON body %s: %s", body, err)
+	}
+	values := make(url.Values)
+	for key, v := range obj {
+		arr, ok := v.([]interface{})
+		if !ok {
+			values.Set(key, fmt.Sprint(v))
+			continue
+		}
+		for i, elem := range arr {
+			for field, fv := range elem.(map[string]interface{}) {
+				values.Set(fmt.Sprintf("%s__%d__%s", key, i, field), fmt.Sprint(fv))
+			}
+		}
+	}
+	return values
+}
+
+func TestJSONRequests(t *testing.T) {
+	tests := []struct {
+		name    string
+		fixture string
+		f       func(*Client, context.Context) error
+	}{
+		{
+			name:    "create_expense",
+			fixture: "fixtures/create_expense.json",
+			f: func(client *Client, ctx context.Context) error {
+				_, err := client.CreateExpense(ctx, CreateExpenseRequest{
+					Cost:        "20.00",
+					Description: "test",
+					Payment:     true,
+					SplitStrategy: SplitManually(
+						UserShare{UserOption: ExistingUser(1), PaidShare: "20.00", OwedShare: "10.00"},
+						UserShare{
+							UserOption: NewUser(CreateFriendRequest{FirstName: "Alan", Email: "alan@example.com"}),
+							PaidShare:  "0.00",
+							OwedShare:  "10.00",
+						},
+					),
+				})
+				return err
+			},
+		},
+		{
+			name:    "create_group",
+			fixture: "fixtures/create_expense.json",
+			f: func(client *Client, ctx context.Context) error {
+				_, err := client.CreateGroup(ctx, CreateGroupRequest{
+					Name:              "Flat",
+					GroupType:         GroupTypeApartment,
+					SimplifyByDefault: true,
+				}, ExistingUser(1), ExistingUser(2))
+				return err
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var contentTypes []string
+			var bodies [][]byte
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				body, _ := ioutil.ReadAll(r.Body)
+				contentTypes = append(contentTypes, r.Header.Get("Content-Type"))
+				bodies = append(bodies, body)
+				fixture, _ := ioutil.ReadFile(tt.fixture)
+				rw.Write(fixture)
+			}))
+			defer server.Close()
+			u, _ := url.Parse(server.URL)
+
+			for _, opts := range [][]Option{nil, {WithJSONRequests()}} {
+				client := NewClient(&testHTTPClient{u: u}, opts...)
+				if err := tt.f(client, context.Background()); err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			}
+			if contentTypes[0] != "application/x-www-form-urlencoded" || contentTypes[1] != "application/json" {
+				t.Fatalf("unexpected content types %v", contentTypes)
+			}
+			form, err := url.ParseQuery(string(bodies[0]))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if decoded := flattenJSON(t, bodies[1]); !reflect.DeepEqual(form, decoded) {
+				t.Errorf("encodings differ:\nform: %v\njson: %v", form, decoded)
+			}
+
+			var typed struct {
+				Users []struct {
+					UserID *int `json:"user_id"`
+				} `json:"users"`
+			}
+			if err := json.Unmarshal(bodies[1], &typed); err != nil {
+				t.Fatalf("expected users to be an array of objects: %s", err)
+			}
+			if len(typed.Users) != 2 || typed.Users[0].UserID == nil || *typed.Users[0].UserID != 1 {
+				t.Errorf("unexpected users: %s", bodies[1])
+			}
+		})
+	}
+}
+
+func TestJSONRequestTypes(t *testing.T) {
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		body, _ = ioutil.ReadAll(r.Body)
+		fixture, _ := ioutil.ReadFile("fixtures/create_expense.json")
+		rw.Write(fixture)
+	}))
+	defer server.Close()
+	u, _ := url.Parse(server.URL)
+	client := NewClient(&testHTTPClient{u: u}, WithJSONRequests())
+
+	groupID := 5
+	_, err := client.CreateExpense(context.Background(), CreateExpenseRequest{
+		Cost:          "20",
+		Description:   "123",
+		Payment:       true,
+		GroupID:       &groupID,
+		SplitStrategy: SplitManually(UserShare{UserOption: ExistingUser(1), PaidShare: "20", OwedShare: "20"}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var got map[string]interface{}
+	if err := json.Unmarshal(body, &got); err != nil {
+		t.Fatal(err)
+	}
+	// Types follow the request struct rather than the look of the values.
+	want := map[string]interface{}{
+		"cost":        "20",
+		"description": "123",
+		"payment":     true,
+		"group_id":    float64(5),
+		"users": []interface{}{
+			map[string]interface{}{"user_id": float64(1), "paid_share": "20", "owed_share": "20"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected body:\ngot:  %v\nwant: %v", got, want)
+	}
+}
@@ -0,0 +1,330 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+)
+
+// fakeAPI is an in-memory stand-in for the splitwise API, used by -demo.
+//
+// It implements just enough of the API for the UI: the current user, groups, friends,
+// and listing and creating expenses. Balances are derived from the expenses created.
+type fakeAPI struct {
+	mu       sync.Mutex
+	me       int
+	users    map[int]splitwise.User
+	groups   []*splitwise.Group
+	expenses []splitwise.Expense
+	nextID   int
+}
+
+func newFakeAPI() *fakeAPI {
+	f := &fakeAPI{
+		me: 1,
+		users: map[int]splitwise.User{
+			1: {ID: 1, FirstName: "Ada", LastName: "Lovelace", Email: "ada@example.com", DefaultCurrency: "GBP"},
+			2: {ID: 2, FirstName: "Grace", LastName: "Hopper", Email: "grace@example.com"},
+			3: {ID: 3, FirstName: "Alan", LastName: "Turing", Email: "alan@example.com"},
+		},
+		groups: []*splitwise.Group{
+			{ID: 10, Name: "Flat", GroupType: splitwise.GroupTypeApartment},
+			{ID: 11, Name: "Paris", GroupType: splitwise.GroupTypeTrip},
+		},
+		nextID: 100,
+	}
+	f.groups[0].Members = f.members(1, 2, 3)
+	f.groups[1].Members = f.members(1, 2)
+
+	day := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+	f.addExpense(10, "Groceries", "GBP", day, map[int][2]int64{1: {4500, 1500}, 2: {0, 1500}, 3: {0, 1500}})
+	f.addExpense(10, "Electricity", "GBP", day.AddDate(0, 0, 3), map[int][2]int64{1: {0, 2000}, 2: {6000, 2000}, 3: {0, 2000}})
+	f.addExpense(11, "Hotel", "EUR", day.AddDate(0, 0, 7), map[int][2]int64{1: {24000, 12000}, 2: {0, 12000}})
+	return f
+}
+
+func (f *fakeAPI) members(ids ...int) []splitwise.GroupMember {
+	members := make([]splitwise.GroupMember, len(ids))
+	for i, id := range ids {
+		u := f.users[id]
+		members[i] = splitwise.GroupMember{ID: id, FirstName: u.FirstName, LastName: u.LastName, Email: u.Email}
+	}
+	return members
+}
+
+// addExpense records an expense with the paid and owed cents of each user.
+func (f *fakeAPI) addExpense(groupID int, description, currency string, date time.Time, shares map[int][2]int64) splitwise.Expense {
+	f.nextID++
+	var cost int64
+	var users []splitwise.ExpenseUser
+	for id, share := range shares {
+		cost += share[0]
+		users = append(users, splitwise.ExpenseUser{
+			UserID:     id,
+			User:       f.users[id],
+			PaidShare:  formatCents(share[0]),
+			OwedShare:  formatCents(share[1]),
+			NetBalance: formatCents(share[0] - share[1]),
+		})
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].UserID < users[j].UserID })
+	e := splitwise.Expense{
+		ID:           f.nextID,
+		Date:         date,
+		CreatedAt:    date,
+		UpdatedAt:    date,
+		Description:  description,
+		Cost:         formatCents(cost),
+		CurrencyCode: currency,
+		Users:        users,
+	}
+	if groupID != 0 {
+		e.GroupID = &groupID
+	}
+	f.expenses = append(f.expenses, e)
+	return e
+}
+
+func (f *fakeAPI) Do(req *http.Request) (*http.Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	parts := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	endpoint, id := parts[len(parts)-1], 0
+	if n, err := strconv.Atoi(endpoint); err == nil && len(parts) > 1 {
+		endpoint, id = parts[len(parts)-2], n
+	}
+
+	var body interface{}
+	switch endpoint {
+	case "get_current_user":
+		body = map[string]interface{}{"user": f.users[f.me]}
+	case "get_groups":
+		groups := make([]splitwise.Group, len(f.groups))
+		for i, g := range f.groups {
+			groups[i] = f.group(g)
+		}
+		body = map[string]interface{}{"groups": groups}
+	case "get_group":
+		for _, g := range f.groups {
+			if g.ID == id {
+				body = map[string]interface{}{"group": f.group(g)}
+			}
+		}
+	case "get_friends":
+		body = map[string]interface{}{"friends": f.friends()}
+	case "get_expenses":
+		body = map[string]interface{}{"expenses": f.listExpenses(req)}
+	case "create_expense":
+		expense, err := f.createExpense(req)
+		if err != nil {
+			body = map[string]interface{}{"errors": map[string][]string{"base": {err.Error()}}}
+		} else {
+			body = map[string]interface{}{"expense": expense}
+		}
+	}
+	if body == nil {
+		return response(req, http.StatusNotFound, []byte(`{"errors": {"base": ["Not found"]}}`)), nil
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	return response(req, http.StatusOK, data), nil
+}
+
+func response(req *http.Request, status int, body []byte) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       ioutil.NopCloser(bytes.NewReader(body)),
+		Request:    req,
+	}
+}
+
+// group returns g with the balance of each member within it.
+func (f *fakeAPI) group(g *splitwise.Group) splitwise.Group {
+	net := make(map[int]map[string]int64)
+	for _, e := range f.expenses {
+		if e.GroupID == nil || *e.GroupID != g.ID || e.DeletedAt != nil {
+			continue
+		}
+		for _, eu := range e.Users {
+			amount, _ := parseCents(eu.NetBalance)
+			if net[eu.UserID] == nil {
+				net[eu.UserID] = make(map[string]int64)
+			}
+			net[eu.UserID][e.CurrencyCode] += amount
+		}
+	}
+	out := *g
+	out.Members = make([]splitwise.GroupMember, len(g.Members))
+	for i, m := range g.Members {
+		m.Balance = balances(net[m.ID])
+		out.Members[i] = m
+	}
+	return out
+}
+
+// friends returns every other user with their balance with the current user.
+//
+// Within each expense, those who are owed money are repaid by those who owe it in
+// proportion to the amounts involved.
+func (f *fakeAPI) friends() []splitwise.Friend {
+	owed := make(map[int]map[string]int64)
+	for _, e := range f.expenses {
+		if e.DeletedAt != nil {
+			continue
+		}
+		net := make(map[int]int64)
+		var positive int64
+		for _, eu := range e.Users {
+			amount, _ := parseCents(eu.NetBalance)
+			net[eu.UserID] = amount
+			if amount > 0 {
+				positive += amount
+			}
+		}
+		if positive == 0 {
+			continue
+		}
+		for id, amount := range net {
+			var debt int64
+			if amount < 0 && net[f.me] > 0 {
+				debt = -amount * net[f.me] / positive
+			} else if amount > 0 && net[f.me] < 0 {
+				debt = net[f.me] * amount / positive
+			}
+			if id == f.me || debt == 0 {
+				continue
+			}
+			if owed[id] == nil {
+				owed[id] = make(map[string]int64)
+			}
+			owed[id][e.CurrencyCode] += debt
+		}
+	}
+	var friends []splitwise.Friend
+	for id, u := range f.users {
+		if id == f.me {
+			continue
+		}
+		friends = append(friends, splitwise.Friend{
+			ID:        id,
+			FirstName: u.FirstName,
+			LastName:  u.LastName,
+			Balance:   balances(owed[id]),
+		})
+	}
+	sort.Slice(friends, func(i, j int) bool { return friends[i].ID < friends[j].ID })
+	return friends
+}
+
+func balances(byCurrency map[string]int64) []splitwise.Balance {
+	out := []splitwise.Balance{}
+	for currency, amount := range byCurrency {
+		if amount != 0 {
+			out = append(out, splitwise.Balance{CurrencyCode: currency, Amount: formatCents(amount)})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CurrencyCode < out[j].CurrencyCode })
+	return out
+}
+
+func (f *fakeAPI) listExpenses(req *http.Request) []splitwise.Expense {
+	query := req.URL.Query()
+	expenses := []splitwise.Expense{}
+	for i := len(f.expenses) - 1; i >= 0; i-- {
+		e := f.expenses[i]
+		if g := query.Get("group_id"); g != "" && (e.GroupID == nil || strconv.Itoa(*e.GroupID) != g) {
+			continue
+		}
+		expenses = append(expenses, e)
+	}
+	sort.SliceStable(expenses, func(i, j int) bool { return expenses[i].Date.After(expenses[j].Date) })
+	offset, _ := strconv.Atoi(query.Get("offset"))
+	if offset > len(expenses) {
+		offset = len(expenses)
+	}
+	expenses = expenses[offset:]
+	if limit, _ := strconv.Atoi(query.Get("limit")); limit > 0 && limit < len(expenses) {
+		expenses = expenses[:limit]
+	}
+	return expenses
+}
+
+func (f *fakeAPI) createExpense(req *http.Request) (splitwise.Expense, error) {
+	if err := req.ParseForm(); err != nil {
+		return splitwise.Expense{}, err
+	}
+	form := req.PostForm
+	cost, err := parseCents(form.Get("cost"))
+	if err != nil || cost <= 0 {
+		return splitwise.Expense{}, fmt.Errorf("invalid cost %q", form.Get("cost"))
+	}
+	groupID, _ := strconv.Atoi(form.Get("group_id"))
+	currency := form.Get("currency_code")
+	if currency == "" {
+		currency = f.users[f.me].DefaultCurrency
+	}
+
+	shares := make(map[int][2]int64)
+	var paid, owed int64
+	for i := 0; form.Get(fmt.Sprintf("users__%d__user_id", i)) != ""; i++ {
+		prefix := fmt.Sprintf("users__%d__", i)
+		id, _ := strconv.Atoi(form.Get(prefix + "user_id"))
+		if _, ok := f.users[id]; !ok {
+			return splitwise.Expense{}, fmt.Errorf("unknown user %d", id)
+		}
+		p, err1 := parseCents(form.Get(prefix + "paid_share"))
+		o, err2 := parseCents(form.Get(prefix + "owed_share"))
+		if err1 != nil || err2 != nil {
+			return splitwise.Expense{}, fmt.Errorf("invalid shares for user %d", id)
+		}
+		shares[id] = [2]int64{p, o}
+		paid += p
+		owed += o
+	}
+	if len(shares) == 0 {
+		// Split equally within the group, paid for by the current user.
+		var members []splitwise.GroupMember
+		for _, g := range f.groups {
+			if g.ID == groupID {
+				members = g.Members
+			}
+		}
+		if len(members) == 0 {
+			return splitwise.Expense{}, fmt.Errorf("unknown group %d", groupID)
+		}
+		for i, o := range splitEvenly(cost, len(members)) {
+			share := shares[members[i].ID]
+			share[1] = o
+			shares[members[i].ID] = share
+		}
+		share := shares[f.me]
+		share[0] = cost
+		shares[f.me] = share
+		paid, owed = cost, cost
+	}
+	if paid != cost || owed != cost {
+		return splitwise.Expense{}, fmt.Errorf("shares do not add up to the cost")
+	}
+
+	date := time.Now().UTC()
+	if d, err := time.Parse(time.RFC3339, form.Get("date")); err == nil {
+		date = d
+	}
+	e := f.addExpense(groupID, form.Get("description"), currency, date, shares)
+	e.Payment, _ = strconv.ParseBool(form.Get("payment"))
+	f.expenses[len(f.expenses)-1] = e
+	return e, nil
+}
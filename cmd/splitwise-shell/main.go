@@ -0,0 +1,68 @@
+// Command splitwise-shell is an interactive, line-based shell for browsing balances and
+// expenses, adding expenses and settling up.
+//
+// Each screen is printed as plain text followed by a prompt for the next command. The
+// shell does not take over the terminal, so it also works over a pipe or a dumb
+// terminal, and its sessions can be scripted.
+//
+// Usage:
+//
+//	splitwise-shell [-api-url url] [-demo]
+//
+// Requests are authenticated with the token in the SPLITWISE_TOKEN environment
+// variable. With -demo, the program runs against an in-memory fake of the API and no
+// token is needed.
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"os/signal"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+	"golang.org/x/oauth2"
+)
+
+func main() {
+	apiURL := flag.String("api-url", "", "base URL of the API, e.g. of a local fake")
+	demo := flag.Bool("demo", false, "run against an in-memory fake of the API")
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var httpClient splitwise.HTTPClient
+	if *demo {
+		httpClient = newFakeAPI()
+	} else {
+		token := os.Getenv("SPLITWISE_TOKEN")
+		if token == "" {
+			fmt.Fprintln(os.Stderr, "splitwise-shell: SPLITWISE_TOKEN is not set (use -demo to try it out)")
+			os.Exit(1)
+		}
+		httpClient = oauth2.NewClient(ctx, oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token}))
+	}
+	var opts []splitwise.Option
+	if *apiURL != "" {
+		u, err := url.Parse(*apiURL)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "splitwise-shell: invalid api url: %s\n", err)
+			os.Exit(1)
+		}
+		opts = append(opts, splitwise.WithBaseURL(u))
+	}
+
+	u := &ui{
+		client: splitwise.NewClient(httpClient, opts...),
+		in:     bufio.NewScanner(os.Stdin),
+		out:    os.Stdout,
+	}
+	if err := u.run(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "splitwise-shell: %s\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,454 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+)
+
+// errQuit is returned by a screen when the user asks to quit.
+var errQuit = errors.New("quit")
+
+// ui is an interactive, line-based terminal interface to an account.
+//
+// Each screen prints its contents followed by the commands available, and then reads
+// a command from the input.
+type ui struct {
+	client *splitwise.Client
+	in     *bufio.Scanner
+	out    io.Writer
+
+	me *splitwise.User
+}
+
+// run shows the home screen until the user quits or the input ends.
+func (u *ui) run(ctx context.Context) error {
+	me, err := u.client.GetCurrentUser(ctx)
+	if err != nil {
+		return err
+	}
+	u.me = me
+	err = u.home(ctx)
+	if errors.Is(err, errQuit) || errors.Is(err, context.Canceled) {
+		return nil
+	}
+	return err
+}
+
+func (u *ui) printf(format string, args ...interface{}) {
+	fmt.Fprintf(u.out, format, args...)
+}
+
+// prompt reads a line of input, returning errQuit once the input is exhausted.
+func (u *ui) prompt(label string) (string, error) {
+	u.printf("%s", label)
+	if !u.in.Scan() {
+		if err := u.in.Err(); err != nil {
+			return "", err
+		}
+		u.printf("\n")
+		return "", errQuit
+	}
+	return strings.TrimSpace(u.in.Text()), nil
+}
+
+// home lists groups and friends along with the balances of the current user.
+func (u *ui) home(ctx context.Context) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		groups, err := u.client.GetGroups(ctx)
+		if err != nil {
+			return err
+		}
+		friends, err := u.client.GetFriends(ctx)
+		if err != nil {
+			return err
+		}
+
+		u.printf("\n== %s ==\n\nGroups\n", fullName(u.me.FirstName, u.me.LastName))
+		for i, g := range groups {
+			u.printf("  g%-3d %-24s %s\n", i+1, g.Name, balanceText(u.memberBalance(g), "you are owed", "you owe"))
+		}
+		u.printf("\nFriends\n")
+		for i, f := range friends {
+			u.printf("  f%-3d %-24s %s\n", i+1, fullName(f.FirstName, f.LastName), balanceText(f.Balance, "owes you", "you owe"))
+		}
+		u.printf("\n[gN] open group  [sN] settle up with friend  [r] refresh  [q] quit\n")
+
+		cmd, err := u.prompt("> ")
+		if err != nil {
+			return err
+		}
+		if i, ok := pick(cmd, "g", len(groups)); ok {
+			err = u.group(ctx, groups[i].ID)
+		} else if i, ok := pick(cmd, "s", len(friends)); ok {
+			err = u.settleUp(ctx, friends[i])
+		} else {
+			switch cmd {
+			case "q":
+				return errQuit
+			case "r", "":
+			default:
+				u.printf("Unknown command %q\n", cmd)
+			}
+		}
+		if errors.Is(err, errQuit) || ctx.Err() != nil {
+			return err
+		}
+		if err != nil {
+			u.printf("Error: %s\n", err)
+		}
+	}
+}
+
+// memberBalance returns the balance of the current user within g.
+func (u *ui) memberBalance(g splitwise.Group) []splitwise.Balance {
+	for _, m := range g.Members {
+		if m.ID == u.me.ID {
+			return m.Balance
+		}
+	}
+	return nil
+}
+
+// group shows the members and recent expenses of a group.
+func (u *ui) group(ctx context.Context, id int) error {
+	for {
+		group, err := u.client.GetGroup(ctx, id)
+		if err != nil {
+			return err
+		}
+		expenses, err := u.client.GetExpenses(ctx, &splitwise.GetExpensesRequest{GroupID: &id, Limit: 20})
+		if err != nil {
+			return err
+		}
+
+		u.printf("\n== %s ==\n\nMembers\n", group.Name)
+		for _, m := range group.Members {
+			u.printf("  %-24s %s\n", u.name(m.ID, m.FirstName, m.LastName), balanceText(m.Balance, "gets back", "owes"))
+		}
+		u.printf("\nExpenses\n")
+		if len(expenses) == 0 {
+			u.printf("  none yet\n")
+		}
+		for _, e := range expenses {
+			if e.DeletedAt != nil {
+				continue
+			}
+			u.printf("  %s  %-24s %10s %s  paid by %s\n",
+				e.Date.Format("2006-01-02"), e.Description, e.Cost, e.CurrencyCode, u.payers(e))
+		}
+		u.printf("\n[a] add expense  [r] refresh  [b] back  [q] quit\n")
+
+		cmd, err := u.prompt("> ")
+		if err != nil {
+			return err
+		}
+		switch cmd {
+		case "a":
+			err = u.addExpense(ctx, group)
+		case "b":
+			return nil
+		case "q":
+			return errQuit
+		case "r", "":
+		default:
+			u.printf("Unknown command %q\n", cmd)
+		}
+		if errors.Is(err, errQuit) || ctx.Err() != nil {
+			return err
+		}
+		if err != nil {
+			u.printf("Error: %s\n", err)
+		}
+	}
+}
+
+// addExpense asks for the details of an expense and how it is split between the
+// members of group, and then creates it.
+func (u *ui) addExpense(ctx context.Context, group *splitwise.Group) error {
+	members := group.Members
+	if len(members) == 0 {
+		u.printf("The group has no members.\n")
+		return nil
+	}
+	description, err := u.prompt("Description (blank to cancel): ")
+	if err != nil || description == "" {
+		return err
+	}
+	cost, err := u.promptAmount("Cost: ", false)
+	if err != nil {
+		return err
+	}
+	currency, err := u.prompt(fmt.Sprintf("Currency [%s]: ", u.me.DefaultCurrency))
+	if err != nil {
+		return err
+	}
+	if currency == "" {
+		currency = u.me.DefaultCurrency
+	}
+
+	payer := 0
+	for i, m := range members {
+		u.printf("  %d. %s\n", i+1, u.name(m.ID, m.FirstName, m.LastName))
+		if m.ID == u.me.ID {
+			payer = i
+		}
+	}
+	for {
+		answer, err := u.prompt(fmt.Sprintf("Paid by [%d]: ", payer+1))
+		if err != nil {
+			return err
+		}
+		if answer == "" {
+			break
+		}
+		if i, ok := pick(answer, "", len(members)); ok {
+			payer = i
+			break
+		}
+		u.printf("Enter a number between 1 and %d\n", len(members))
+	}
+
+	answer, err := u.prompt("Split [e]qually or by [a]mounts? [e]: ")
+	if err != nil {
+		return err
+	}
+	var owed []int64
+	if strings.HasPrefix(answer, "a") {
+		owed, err = u.promptShares(members, cost)
+		if err != nil {
+			return err
+		}
+	} else {
+		owed = splitEvenly(cost, len(members))
+	}
+
+	shares := make([]splitwise.UserShare, len(members))
+	for i, m := range members {
+		var paid int64
+		if i == payer {
+			paid = cost
+		}
+		shares[i] = splitwise.UserShare{
+			UserOption: splitwise.ExistingUser(m.ID),
+			PaidShare:  formatCents(paid),
+			OwedShare:  formatCents(owed[i]),
+		}
+	}
+	expense, err := u.client.CreateExpense(ctx, splitwise.CreateExpenseRequest{
+		Cost:          formatCents(cost),
+		Description:   description,
+		GroupID:       &group.ID,
+		CurrencyCode:  &currency,
+		SplitStrategy: splitwise.SplitManually(shares...),
+	})
+	if err != nil {
+		return err
+	}
+	u.printf("Added %q for %s %s.\n", expense.Description, formatCents(cost), currency)
+	return nil
+}
+
+// promptShares asks for the amount owed by each member until they add up to cost.
+func (u *ui) promptShares(members []splitwise.GroupMember, cost int64) ([]int64, error) {
+	for {
+		owed := make([]int64, len(members))
+		var total int64
+		for i, m := range members {
+			amount, err := u.promptAmount(fmt.Sprintf("  %s owes: ", u.name(m.ID, m.FirstName, m.LastName)), true)
+			if err != nil {
+				return nil, err
+			}
+			owed[i] = amount
+			total += amount
+		}
+		if total == cost {
+			return owed, nil
+		}
+		u.printf("The shares add up to %s rather than %s, try again.\n", formatCents(total), formatCents(cost))
+	}
+}
+
+// promptAmount reads a positive amount, or zero if blank is true and the input is blank.
+func (u *ui) promptAmount(label string, blank bool) (int64, error) {
+	for {
+		answer, err := u.prompt(label)
+		if err != nil {
+			return 0, err
+		}
+		if answer == "" && blank {
+			return 0, nil
+		}
+		amount, err := parseCents(answer)
+		if err == nil && (amount > 0 || blank && amount == 0) {
+			return amount, nil
+		}
+		u.printf("Enter an amount such as 12.50\n")
+	}
+}
+
+// settleUp records a payment for each outstanding balance with a friend.
+func (u *ui) settleUp(ctx context.Context, friend splitwise.Friend) error {
+	name := fullName(friend.FirstName, friend.LastName)
+	settled := true
+	for _, b := range friend.Balance {
+		amount, err := parseCents(b.Amount)
+		if err != nil || amount == 0 {
+			continue
+		}
+		settled = false
+		// A positive balance is owed by the friend to the current user.
+		from, to := friend.ID, u.me.ID
+		question := fmt.Sprintf("Record %s paying you %s %s? [y/N] ", name, formatCents(amount), b.CurrencyCode)
+		if amount < 0 {
+			amount = -amount
+			from, to = to, from
+			question = fmt.Sprintf("Record you paying %s %s %s? [y/N] ", name, formatCents(amount), b.CurrencyCode)
+		}
+		answer, err := u.prompt(question)
+		if err != nil {
+			return err
+		}
+		if !strings.HasPrefix(strings.ToLower(answer), "y") {
+			continue
+		}
+		currency := b.CurrencyCode
+		_, err = u.client.CreateExpense(ctx, splitwise.CreateExpenseRequest{
+			Cost:         formatCents(amount),
+			Description:  "Payment",
+			Payment:      true,
+			CurrencyCode: &currency,
+			SplitStrategy: splitwise.SplitManually(
+				splitwise.UserShare{UserOption: splitwise.ExistingUser(from), PaidShare: formatCents(amount), OwedShare: "0.00"},
+				splitwise.UserShare{UserOption: splitwise.ExistingUser(to), PaidShare: "0.00", OwedShare: formatCents(amount)},
+			),
+		})
+		if err != nil {
+			return err
+		}
+		u.printf("Recorded a payment of %s %s.\n", formatCents(amount), currency)
+	}
+	if settled {
+		u.printf("You and %s are settled up.\n", name)
+	}
+	return nil
+}
+
+// name returns "you" for the current user, or the full name of anyone else.
+func (u *ui) name(id int, first, last string) string {
+	if id == u.me.ID {
+		return "you"
+	}
+	return fullName(first, last)
+}
+
+// payers lists who paid for an expense.
+func (u *ui) payers(e splitwise.Expense) string {
+	var names []string
+	for _, eu := range e.Users {
+		if paid, err := parseCents(eu.PaidShare); err == nil && paid > 0 {
+			names = append(names, u.name(eu.UserID, eu.User.FirstName, eu.User.LastName))
+		}
+	}
+	if len(names) == 0 {
+		return "nobody"
+	}
+	return strings.Join(names, ", ")
+}
+
+// pick parses a command such as "g2" into the zero-based index of one of n items.
+func pick(cmd, prefix string, n int) (int, bool) {
+	if !strings.HasPrefix(cmd, prefix) {
+		return 0, false
+	}
+	i, err := strconv.Atoi(strings.TrimSpace(cmd[len(prefix):]))
+	if err != nil || i < 1 || i > n {
+		return 0, false
+	}
+	return i - 1, true
+}
+
+func fullName(first, last string) string {
+	return strings.TrimSpace(first + " " + last)
+}
+
+// balanceText describes balances using positive for amounts owed to the subject and
+// negative for amounts owed by them, e.g. "owes you 12.50 GBP".
+func balanceText(balances []splitwise.Balance, positive, negative string) string {
+	var parts []string
+	for _, b := range balances {
+		amount, err := parseCents(b.Amount)
+		if err != nil || amount == 0 {
+			continue
+		}
+		if amount > 0 {
+			parts = append(parts, fmt.Sprintf("%s %s %s", positive, formatCents(amount), b.CurrencyCode))
+		} else {
+			parts = append(parts, fmt.Sprintf("%s %s %s", negative, formatCents(-amount), b.CurrencyCode))
+		}
+	}
+	if len(parts) == 0 {
+		return "settled up"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// parseCents parses a decimal amount such as "-12.5" into a whole number of cents.
+func parseCents(s string) (int64, error) {
+	neg := strings.HasPrefix(s, "-")
+	digits := strings.TrimPrefix(s, "-")
+	whole, frac := digits, ""
+	if i := strings.IndexByte(digits, '.'); i >= 0 {
+		whole, frac = digits[:i], digits[i+1:]
+	}
+	if len(frac) > 2 || whole == "" && frac == "" || strings.ContainsAny(whole+frac, "+-") {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+	frac += strings.Repeat("0", 2-len(frac))
+	if whole == "" {
+		whole = "0"
+	}
+	w, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+	f, err := strconv.ParseInt(frac, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+	cents := w*100 + f
+	if neg {
+		cents = -cents
+	}
+	return cents, nil
+}
+
+func formatCents(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+// splitEvenly divides cents into n shares, giving any remainder to the first shares.
+func splitEvenly(cents int64, n int) []int64 {
+	shares := make([]int64, n)
+	for i := range shares {
+		shares[i] = cents / int64(n)
+		if int64(i) < cents%int64(n) {
+			shares[i]++
+		}
+	}
+	return shares
+}
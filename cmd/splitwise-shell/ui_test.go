@@ -0,0 +1,136 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+)
+
+// session runs the UI against a fake with the given lines of input.
+func session(t *testing.T, fake *fakeAPI, input ...string) string {
+	var out bytes.Buffer
+	u := &ui{
+		client: splitwise.NewClient(fake),
+		in:     bufio.NewScanner(strings.NewReader(strings.Join(input, "\n") + "\n")),
+		out:    &out,
+	}
+	if err := u.run(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s\n%s", err, out.String())
+	}
+	return out.String()
+}
+
+func TestHomeShowsBalances(t *testing.T) {
+	out := session(t, newFakeAPI(), "q")
+	for _, want := range []string{
+		"g1   Flat                     you are owed 10.00 GBP",
+		"g2   Paris                    you are owed 120.00 EUR",
+		"f1   Grace Hopper             owes you 120.00 EUR, you owe 5.00 GBP",
+		"f2   Alan Turing              owes you 15.00 GBP",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in output:\n%s", want, out)
+		}
+	}
+}
+
+func TestGroupExpenses(t *testing.T) {
+	out := session(t, newFakeAPI(), "g1", "b", "q")
+	for _, want := range []string{
+		"== Flat ==",
+		"Grace Hopper             gets back 25.00 GBP",
+		"2021-03-04  Electricity                   60.00 GBP  paid by Grace Hopper",
+		"2021-03-01  Groceries                     45.00 GBP  paid by you",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in output:\n%s", want, out)
+		}
+	}
+}
+
+func TestAddExpense(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []string
+		shares map[int][2]string
+	}{
+		{
+			name:   "equally",
+			input:  []string{"Pizza", "10", "", "", "e"},
+			shares: map[int][2]string{1: {"10.00", "3.34"}, 2: {"0.00", "3.33"}, 3: {"0.00", "3.33"}},
+		},
+		{
+			name: "by amounts",
+			// The first set of shares does not add up, so they are asked for again.
+			input:  []string{"Pizza", "abc", "10", "EUR", "2", "a", "5", "5", "5", "6", "", "4"},
+			shares: map[int][2]string{1: {"0.00", "6.00"}, 2: {"10.00", "0.00"}, 3: {"0.00", "4.00"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := newFakeAPI()
+			input := append([]string{"g1", "a"}, tt.input...)
+			out := session(t, fake, append(input, "q")...)
+			if !strings.Contains(out, `Added "Pizza"`) {
+				t.Fatalf("expected the expense to be added:\n%s", out)
+			}
+			e := fake.expenses[len(fake.expenses)-1]
+			if e.GroupID == nil || *e.GroupID != 10 || e.Cost != "10.00" {
+				t.Fatalf("unexpected expense: %+v", e)
+			}
+			for _, eu := range e.Users {
+				if got := [2]string{eu.PaidShare, eu.OwedShare}; got != tt.shares[eu.UserID] {
+					t.Errorf("expected shares %v for user %d, got %v", tt.shares[eu.UserID], eu.UserID, got)
+				}
+			}
+		})
+	}
+}
+
+func TestSettleUp(t *testing.T) {
+	fake := newFakeAPI()
+	out := session(t, fake, "s1", "y", "n", "s2", "y", "s2", "q")
+	if !strings.Contains(out, "Record Grace Hopper paying you 120.00 EUR?") ||
+		!strings.Contains(out, "Record you paying Grace Hopper 5.00 GBP?") {
+		t.Errorf("expected each balance to be settled separately:\n%s", out)
+	}
+	if !strings.Contains(out, "You and Alan Turing are settled up.") {
+		t.Errorf("expected Alan to be settled up:\n%s", out)
+	}
+	friends := fake.friends()
+	if got := balanceText(friends[0].Balance, "owes you", "you owe"); got != "you owe 5.00 GBP" {
+		t.Errorf("unexpected balance with Grace: %s", got)
+	}
+	last := fake.expenses[len(fake.expenses)-1]
+	if !last.Payment || last.Cost != "15.00" {
+		t.Errorf("expected a payment to be recorded, got %+v", last)
+	}
+}
+
+func TestParseCents(t *testing.T) {
+	tests := []struct {
+		in    string
+		cents int64
+		ok    bool
+	}{
+		{"12.50", 1250, true},
+		{"12.5", 1250, true},
+		{"12", 1200, true},
+		{".5", 50, true},
+		{"-3.07", -307, true},
+		{"", 0, false},
+		{"1.234", 0, false},
+		{"1.-2", 0, false},
+		{"abc", 0, false},
+	}
+	for _, tt := range tests {
+		cents, err := parseCents(tt.in)
+		if (err == nil) != tt.ok || cents != tt.cents {
+			t.Errorf("parseCents(%q) = %d, %v", tt.in, cents, err)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/cwbriones/go-splitwise/watch"
+)
+
+const (
+	signatureHeader = "X-Splitwise-Signature"
+	eventHeader     = "X-Splitwise-Event"
+	deliveryHeader  = "X-Splitwise-Delivery"
+)
+
+// payload is the body POSTed to each endpoint.
+type payload struct {
+	ID        string      `json:"id"`
+	Type      string      `json:"type"`
+	ObjectID  int         `json:"object_id"`
+	CreatedAt time.Time   `json:"created_at"`
+	Data      watch.Event `json:"data"`
+}
+
+// deadLetter is a delivery that failed on every attempt.
+type deadLetter struct {
+	Endpoint string          `json:"endpoint"`
+	Attempts int             `json:"attempts"`
+	Error    string          `json:"error"`
+	FailedAt time.Time       `json:"failed_at"`
+	Payload  json.RawMessage `json:"payload"`
+}
+
+// dispatcher delivers events to webhook endpoints.
+type dispatcher struct {
+	client      *http.Client
+	endpoints   []endpoint
+	maxAttempts int
+	backoff     time.Duration
+	deadLetters string
+}
+
+// dispatch delivers event to every endpoint subscribed to it.
+//
+// Deliveries which fail on every attempt are appended to the dead letter file. An error
+// is only returned if the dead letter itself could not be written.
+func (d *dispatcher) dispatch(ctx context.Context, event watch.Event) error {
+	id, err := deliveryID(event)
+	if err != nil {
+		return fmt.Errorf("encode event: %w", err)
+	}
+	body, err := json.Marshal(payload{
+		ID:        id,
+		Type:      event.Type(),
+		ObjectID:  event.ObjectID(),
+		CreatedAt: time.Now().UTC(),
+		Data:      event,
+	})
+	if err != nil {
+		return fmt.Errorf("encode event: %w", err)
+	}
+	for _, ep := range d.endpoints {
+		if !ep.subscribed(event.Type()) {
+			continue
+		}
+		attempts, err := d.deliver(ctx, ep, id, event.Type(), body)
+		if err == nil {
+			continue
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := d.writeDeadLetter(deadLetter{
+			Endpoint: ep.URL,
+			Attempts: attempts,
+			Error:    err.Error(),
+			FailedAt: time.Now().UTC(),
+			Payload:  body,
+		}); err != nil {
+			return fmt.Errorf("write dead letter: %w", err)
+		}
+	}
+	return nil
+}
+
+// deliver POSTs body to ep, retrying with exponential backoff. It returns the number
+// of attempts made.
+func (d *dispatcher) deliver(ctx context.Context, ep endpoint, id, eventType string, body []byte) (int, error) {
+	backoff := d.backoff
+	var err error
+	for attempt := 1; ; attempt++ {
+		if err = d.post(ctx, ep, id, eventType, body); err == nil {
+			return attempt, nil
+		}
+		if attempt >= d.maxAttempts {
+			return attempt, err
+		}
+		select {
+		case <-ctx.Done():
+			return attempt, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func (d *dispatcher) post(ctx context.Context, ep endpoint, id, eventType string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ep.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(eventHeader, eventType)
+	req.Header.Set(deliveryHeader, id)
+	if ep.Secret != "" {
+		req.Header.Set(signatureHeader, sign(ep.Secret, body))
+	}
+	res, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d", res.StatusCode)
+	}
+	return nil
+}
+
+func (d *dispatcher) writeDeadLetter(dl deadLetter) error {
+	f, err := os.OpenFile(d.deadLetters, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if err := json.NewEncoder(f).Encode(dl); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// sign returns the value of the signature header for body, the hex-encoded
+// HMAC-SHA256 of the body keyed by secret.
+func sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// deliveryID identifies an event, so that receivers may discard an event delivered
+// again after a restart, before the watch state recording it was saved. It is the
+// hex-encoded SHA-256 of the event type and data, truncated to 128 bits.
+func deliveryID(event watch.Event) (string, error) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	h.Write([]byte(event.Type()))
+	h.Write([]byte{0})
+	h.Write(data)
+	return hex.EncodeToString(h.Sum(nil)[:16]), nil
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+	"github.com/cwbriones/go-splitwise/watch"
+)
+
+func newTestDispatcher(t *testing.T, endpoints ...endpoint) *dispatcher {
+	return &dispatcher{
+		client:      &http.Client{},
+		endpoints:   endpoints,
+		maxAttempts: 3,
+		backoff:     time.Millisecond,
+		deadLetters: filepath.Join(t.TempDir(), "dead-letters.jsonl"),
+	}
+}
+
+func TestDispatchSignsAndRetries(t *testing.T) {
+	var attempts int
+	var received payload
+	var receivedData struct {
+		Expense splitwise.Expense `json:"expense"`
+	}
+	receiver := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			rw.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if r.Header.Get(signatureHeader) != sign("s3cret", body) {
+			t.Errorf("invalid signature %q", r.Header.Get(signatureHeader))
+		}
+		if r.Header.Get(eventHeader) != "expense.created" {
+			t.Errorf("unexpected event header %q", r.Header.Get(eventHeader))
+		}
+		var raw struct {
+			payload
+			Data json.RawMessage `json:"data"`
+		}
+		json.Unmarshal(body, &raw)
+		received = raw.payload
+		json.Unmarshal(raw.Data, &receivedData)
+	}))
+	defer receiver.Close()
+
+	d := newTestDispatcher(t, endpoint{URL: receiver.URL, Secret: "s3cret"})
+	event := watch.ExpenseCreated{Expense: splitwise.Expense{ID: 42, Description: "dinner"}}
+	if err := d.dispatch(context.Background(), event); err != nil {
+		t.Fatalf("dispatch: %s", err)
+	}
+	if attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+	if received.Type != "expense.created" || received.ObjectID != 42 || received.ID == "" {
+		t.Errorf("unexpected payload: %+v", received)
+	}
+	if receivedData.Expense.Description != "dinner" {
+		t.Errorf("unexpected data: %+v", receivedData)
+	}
+	if _, err := os.Stat(d.deadLetters); !os.IsNotExist(err) {
+		t.Errorf("expected no dead letters")
+	}
+}
+
+func TestDispatchDeadLetters(t *testing.T) {
+	var attempts int
+	receiver := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		attempts++
+		rw.WriteHeader(http.StatusBadGateway)
+	}))
+	defer receiver.Close()
+
+	d := newTestDispatcher(t,
+		endpoint{URL: receiver.URL},
+		endpoint{URL: receiver.URL, Events: []string{"group.deleted"}},
+	)
+	event := watch.ExpenseDeleted{Expense: splitwise.Expense{ID: 7}}
+	if err := d.dispatch(context.Background(), event); err != nil {
+		t.Fatalf("dispatch: %s", err)
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+
+	f, err := os.Open(d.deadLetters)
+	if err != nil {
+		t.Fatalf("open dead letters: %s", err)
+	}
+	defer f.Close()
+	var letters []deadLetter
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var dl deadLetter
+		if err := json.Unmarshal(scanner.Bytes(), &dl); err != nil {
+			t.Fatalf("decode dead letter: %s", err)
+		}
+		letters = append(letters, dl)
+	}
+	if len(letters) != 1 {
+		t.Fatalf("expected 1 dead letter, got %d", len(letters))
+	}
+	if letters[0].Attempts != 3 || letters[0].Endpoint != receiver.URL {
+		t.Errorf("unexpected dead letter: %+v", letters[0])
+	}
+}
+
+func TestDispatchDeliveryID(t *testing.T) {
+	var ids []string
+	receiver := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		ids = append(ids, r.Header.Get(deliveryHeader))
+	}))
+	defer receiver.Close()
+
+	d := newTestDispatcher(t, endpoint{URL: receiver.URL})
+	updatedAt := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	events := []watch.Event{
+		watch.ExpenseCreated{Expense: splitwise.Expense{ID: 42, UpdatedAt: updatedAt}},
+		// The same event, delivered again after a restart.
+		watch.ExpenseCreated{Expense: splitwise.Expense{ID: 42, UpdatedAt: updatedAt}},
+		watch.ExpenseDeleted{Expense: splitwise.Expense{ID: 42, UpdatedAt: updatedAt}},
+		watch.ExpenseCreated{Expense: splitwise.Expense{ID: 42, UpdatedAt: updatedAt.Add(time.Minute)}},
+	}
+	for _, event := range events {
+		if err := d.dispatch(context.Background(), event); err != nil {
+			t.Fatalf("dispatch: %s", err)
+		}
+	}
+	if len(ids) != 4 || ids[0] == "" {
+		t.Fatalf("unexpected delivery ids %q", ids)
+	}
+	if ids[0] != ids[1] {
+		t.Errorf("expected a redelivered event to keep its id, got %q and %q", ids[0], ids[1])
+	}
+	if ids[0] == ids[2] || ids[0] == ids[3] {
+		t.Errorf("expected different events to have different ids, got %q", ids)
+	}
+}
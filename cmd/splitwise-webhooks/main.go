@@ -0,0 +1,169 @@
+// Command splitwise-webhooks polls a splitwise account for changes and delivers them
+// as webhooks.
+//
+// Changes to expenses, groups and friends are POSTed as JSON to every configured
+// endpoint. If an endpoint has a secret, each request carries an X-Splitwise-Signature
+// header holding the hex-encoded HMAC-SHA256 of the body:
+//
+//	X-Splitwise-Signature: sha256=5d41402abc4b2a76b9719d911017c592...
+//
+// Each request also carries an X-Splitwise-Delivery header identifying the event. An
+// event may be delivered more than once, e.g. if the command is restarted before
+// saving its state, but always with the same delivery ID.
+//
+// Failed deliveries are retried with exponential backoff, and appended to the dead
+// letter file once all attempts have been exhausted.
+//
+// Usage:
+//
+//	splitwise-webhooks -config webhooks.json
+//
+// The configuration file looks like:
+//
+//	{
+//	  "state": "splitwise-state.json",
+//	  "dead_letters": "dead-letters.jsonl",
+//	  "interval": "1m",
+//	  "max_attempts": 5,
+//	  "backoff": "1s",
+//	  "endpoints": [
+//	    {"url": "https://example.com/hooks/splitwise", "secret": "...", "events": ["expense.created"]}
+//	  ]
+//	}
+//
+// The API token is read from the SPLITWISE_TOKEN environment variable, or the "token"
+// field of the configuration.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+	"github.com/cwbriones/go-splitwise/mirror"
+	"github.com/cwbriones/go-splitwise/watch"
+	"golang.org/x/oauth2"
+)
+
+type config struct {
+	Token       string     `json:"token"`
+	State       string     `json:"state"`
+	DeadLetters string     `json:"dead_letters"`
+	Interval    duration   `json:"interval"`
+	MaxAttempts int        `json:"max_attempts"`
+	Backoff     duration   `json:"backoff"`
+	Endpoints   []endpoint `json:"endpoints"`
+}
+
+type endpoint struct {
+	URL    string `json:"url"`
+	Secret string `json:"secret"`
+	// Events restricts the event types delivered to the endpoint. If empty, all
+	// events are delivered.
+	Events []string `json:"events"`
+}
+
+func (ep endpoint) subscribed(eventType string) bool {
+	if len(ep.Events) == 0 {
+		return true
+	}
+	for _, e := range ep.Events {
+		if e == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// duration is a time.Duration which is decoded from strings such as "1m".
+type duration time.Duration
+
+func (d *duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = duration(parsed)
+	return nil
+}
+
+func loadConfig(path string) (*config, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &config{
+		State:       "splitwise-state.json",
+		DeadLetters: "dead-letters.jsonl",
+		Interval:    duration(time.Minute),
+		MaxAttempts: 5,
+		Backoff:     duration(time.Second),
+	}
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	if token := os.Getenv("SPLITWISE_TOKEN"); token != "" {
+		cfg.Token = token
+	}
+	if cfg.Token == "" {
+		return nil, errors.New("no API token: set SPLITWISE_TOKEN")
+	}
+	if len(cfg.Endpoints) == 0 {
+		return nil, errors.New("no endpoints configured")
+	}
+	return cfg, nil
+}
+
+func main() {
+	configPath := flag.String("config", "webhooks.json", "path to the configuration file")
+	flag.Parse()
+
+	cfg, err := loadConfig(*configPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := run(ctx, cfg); err != nil && !errors.Is(err, context.Canceled) {
+		log.Fatal(err)
+	}
+}
+
+func run(ctx context.Context, cfg *config) error {
+	httpClient := oauth2.NewClient(ctx, oauth2.StaticTokenSource(&oauth2.Token{AccessToken: cfg.Token}))
+	watcher := watch.New(
+		splitwise.NewClient(httpClient),
+		mirror.NewFileStore(cfg.State),
+		watch.WithInterval(time.Duration(cfg.Interval)),
+		watch.WithGroups(),
+		watch.WithFriends(),
+		watch.WithErrorHandler(func(err error) {
+			log.Printf("poll failed: %s", err)
+		}),
+	)
+	d := &dispatcher{
+		client:      &http.Client{Timeout: 30 * time.Second},
+		endpoints:   cfg.Endpoints,
+		maxAttempts: cfg.MaxAttempts,
+		backoff:     time.Duration(cfg.Backoff),
+		deadLetters: cfg.DeadLetters,
+	}
+
+	// Events are dispatched before the state of the poll that found them is saved, so
+	// that events in flight when the process exits are delivered again on restart.
+	return watcher.RunFunc(ctx, d.dispatch)
+}
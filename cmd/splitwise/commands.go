@@ -0,0 +1,307 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+)
+
+const dateLayout = "2006-01-02"
+
+func newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	return fs
+}
+
+// parseID parses the single positional ID argument of a command.
+func parseID(args []string, what string) (int, error) {
+	if len(args) != 1 {
+		return 0, fmt.Errorf("expected a %s", what)
+	}
+	id, err := strconv.Atoi(args[0])
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", what, args[0])
+	}
+	return id, nil
+}
+
+// intList is a flag which may be repeated to collect several IDs.
+type intList []int
+
+func (l *intList) String() string {
+	return fmt.Sprint([]int(*l))
+}
+
+func (l *intList) Set(s string) error {
+	id, err := strconv.Atoi(s)
+	if err != nil {
+		return err
+	}
+	*l = append(*l, id)
+	return nil
+}
+
+// dateFlag is an optional date flag.
+type dateFlag struct {
+	t *time.Time
+}
+
+func (d *dateFlag) String() string {
+	if d.t == nil {
+		return ""
+	}
+	return d.t.Format(dateLayout)
+}
+
+func (d *dateFlag) Set(s string) error {
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return err
+	}
+	d.t = &t
+	return nil
+}
+
+func whoami(ctx context.Context, a *app, args []string) error {
+	user, err := a.client.GetCurrentUser(ctx)
+	if err != nil {
+		return err
+	}
+	return a.out.print(user, func(tw *tabwriter.Writer) {
+		row(tw, "ID", user.ID)
+		row(tw, "Name", fullName(user.FirstName, user.LastName))
+		row(tw, "Email", user.Email)
+		row(tw, "Currency", user.DefaultCurrency)
+	})
+}
+
+func groupsList(ctx context.Context, a *app, args []string) error {
+	groups, err := a.client.GetGroups(ctx)
+	if err != nil {
+		return err
+	}
+	return a.out.print(groups, func(tw *tabwriter.Writer) {
+		row(tw, "ID", "NAME", "TYPE", "MEMBERS")
+		for _, g := range groups {
+			row(tw, g.ID, g.Name, g.GroupType, len(g.Members))
+		}
+	})
+}
+
+func groupsShow(ctx context.Context, a *app, args []string) error {
+	id, err := parseID(args, "group id")
+	if err != nil {
+		return err
+	}
+	group, err := a.client.GetGroup(ctx, id)
+	if err != nil {
+		return err
+	}
+	return a.out.print(group, func(tw *tabwriter.Writer) {
+		row(tw, "ID", group.ID)
+		row(tw, "Name", group.Name)
+		row(tw, "Type", group.GroupType)
+		row(tw, "Simplify debts", group.SimplifyByDefault)
+		fmt.Fprintln(tw)
+		row(tw, "MEMBER", "NAME", "BALANCE")
+		for _, m := range group.Members {
+			row(tw, m.ID, fullName(m.FirstName, m.LastName), formatBalances(m.Balance))
+		}
+	})
+}
+
+func groupsCreate(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("groups create")
+	name := fs.String("name", "", "name of the group")
+	groupType := fs.String("type", "other", "type of group: apartment, house, trip or other")
+	simplify := fs.Bool("simplify", false, "simplify debts by default")
+	var members intList
+	fs.Var(&members, "member", "ID of a user to add to the group, may be repeated")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *name == "" {
+		return errors.New("-name is required")
+	}
+	req := splitwise.CreateGroupRequest{
+		Name:              *name,
+		SimplifyByDefault: *simplify,
+	}
+	if err := req.GroupType.UnmarshalText([]byte(*groupType)); err != nil {
+		return fmt.Errorf("invalid group type %q: %w", *groupType, err)
+	}
+	if !req.GroupType.Known() {
+		return fmt.Errorf("unknown group type %q", *groupType)
+	}
+	me, err := a.client.GetCurrentUser(ctx)
+	if err != nil {
+		return err
+	}
+	var others []splitwise.UserOption
+	for _, id := range members {
+		others = append(others, splitwise.ExistingUser(id))
+	}
+	group, err := a.client.CreateGroup(ctx, req, splitwise.ExistingUser(me.ID), others...)
+	if err != nil {
+		return err
+	}
+	return a.out.message(group, "Created group %d (%s)", group.ID, group.Name)
+}
+
+func friendsList(ctx context.Context, a *app, args []string) error {
+	friends, err := a.client.GetFriends(ctx)
+	if err != nil {
+		return err
+	}
+	return a.out.print(friends, func(tw *tabwriter.Writer) {
+		row(tw, "ID", "NAME", "BALANCE")
+		for _, f := range friends {
+			row(tw, f.ID, fullName(f.FirstName, f.LastName), formatBalances(f.Balance))
+		}
+	})
+}
+
+func friendsAdd(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("friends add")
+	req := &splitwise.CreateFriendRequest{}
+	fs.StringVar(&req.Email, "email", "", "email address of the friend")
+	fs.StringVar(&req.FirstName, "first", "", "first name of the friend")
+	fs.StringVar(&req.LastName, "last", "", "last name of the friend")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if req.Email == "" {
+		return errors.New("-email is required")
+	}
+	friend, err := a.client.CreateFriend(ctx, req)
+	if err != nil {
+		return err
+	}
+	return a.out.message(friend, "Added friend %d (%s)", friend.ID, fullName(friend.FirstName, friend.LastName))
+}
+
+func expensesList(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("expenses list")
+	limit := fs.Int("limit", 20, "maximum number of expenses to list")
+	var after, before dateFlag
+	fs.Var(&after, "after", "only list expenses dated after this date (YYYY-MM-DD)")
+	fs.Var(&before, "before", "only list expenses dated before this date (YYYY-MM-DD)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	expenses, err := a.client.GetExpenses(ctx, &splitwise.GetExpensesRequest{
+		Limit:       *limit,
+		DatedAfter:  after.t,
+		DatedBefore: before.t,
+	})
+	if err != nil {
+		return err
+	}
+	return a.out.print(expenses, func(tw *tabwriter.Writer) {
+		row(tw, "ID", "DATE", "DESCRIPTION", "COST", "CATEGORY")
+		for _, e := range expenses {
+			description := e.Description
+			if e.DeletedAt != nil {
+				description += " (deleted)"
+			}
+			row(tw, e.ID, e.Date.Format(dateLayout), description, strings.TrimSpace(e.Cost+" "+e.CurrencyCode), e.Category.Name)
+		}
+	})
+}
+
+func expensesAdd(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("expenses add")
+	cost := fs.String("cost", "", "total cost of the expense, e.g. 12.50")
+	description := fs.String("description", "", "description of the expense")
+	group := fs.Int("group", 0, "ID of the group to split the expense equally within")
+	currency := fs.String("currency", "", "currency code of the expense")
+	details := fs.String("details", "", "additional notes")
+	var date dateFlag
+	fs.Var(&date, "date", "date of the expense (YYYY-MM-DD)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *cost == "" || *description == "" || *group == 0 {
+		return errors.New("-cost, -description and -group are required")
+	}
+	req := splitwise.CreateExpenseRequest{
+		Cost:          *cost,
+		Description:   *description,
+		SplitStrategy: splitwise.SplitEqually(*group),
+		Date:          date.t,
+	}
+	if *currency != "" {
+		req.CurrencyCode = currency
+	}
+	if *details != "" {
+		req.Details = details
+	}
+	expense, err := a.client.CreateExpense(ctx, req)
+	if err != nil {
+		return err
+	}
+	return a.out.message(expense, "Added expense %d (%s)", expense.ID, expense.Description)
+}
+
+func expensesDelete(ctx context.Context, a *app, args []string) error {
+	id, err := parseID(args, "expense id")
+	if err != nil {
+		return err
+	}
+	if err := a.client.DeleteExpense(ctx, id); err != nil {
+		return err
+	}
+	return a.out.message(map[string]interface{}{"id": id, "deleted": true}, "Deleted expense %d", id)
+}
+
+func expensesUndelete(ctx context.Context, a *app, args []string) error {
+	id, err := parseID(args, "expense id")
+	if err != nil {
+		return err
+	}
+	if err := a.client.UndeleteExpense(ctx, id); err != nil {
+		return err
+	}
+	return a.out.message(map[string]interface{}{"id": id, "deleted": false}, "Restored expense %d", id)
+}
+
+func commentsList(ctx context.Context, a *app, args []string) error {
+	id, err := parseID(args, "expense id")
+	if err != nil {
+		return err
+	}
+	comments, err := a.client.GetComments(ctx, id)
+	if err != nil {
+		return err
+	}
+	return a.out.print(comments, func(tw *tabwriter.Writer) {
+		row(tw, "ID", "AUTHOR", "COMMENT")
+		for _, c := range comments {
+			row(tw, c.ID, fullName(c.User.FirstName, c.User.LastName), c.Content)
+		}
+	})
+}
+
+func commentsAdd(ctx context.Context, a *app, args []string) error {
+	if len(args) < 2 {
+		return errors.New("expected an expense id and comment")
+	}
+	id, err := parseID(args[:1], "expense id")
+	if err != nil {
+		return err
+	}
+	comment, err := a.client.CreateComment(ctx, id, strings.Join(args[1:], " "))
+	if err != nil {
+		return err
+	}
+	return a.out.message(comment, "Added comment %d", comment.ID)
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// config holds the settings of the CLI.
+//
+// Settings are read from the configuration file, then overridden by the environment.
+type config struct {
+	// Token is the API key or OAuth access token used to authenticate.
+	Token string `json:"token"`
+	// APIURL overrides the address of the splitwise API, e.g. to use a local fake.
+	APIURL string `json:"api_url"`
+}
+
+// defaultConfigPath returns the location of the configuration file when it is not
+// given explicitly.
+func defaultConfigPath(getenv func(string) string) string {
+	if path := getenv("SPLITWISE_CONFIG"); path != "" {
+		return path
+	}
+	dir := getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home := getenv("HOME")
+		if home == "" {
+			return ""
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "splitwise", "config.json")
+}
+
+// loadConfig reads the configuration file at path, if it exists, and applies any
+// overrides from the environment.
+func loadConfig(path string, explicit bool, getenv func(string) string) (*config, error) {
+	cfg := &config{}
+	if path != "" {
+		data, err := ioutil.ReadFile(path)
+		switch {
+		case err == nil:
+			if err := json.Unmarshal(data, cfg); err != nil {
+				return nil, fmt.Errorf("parse %s: %w", path, err)
+			}
+		case os.IsNotExist(err) && !explicit:
+		default:
+			return nil, err
+		}
+	}
+	if token := getenv("SPLITWISE_TOKEN"); token != "" {
+		cfg.Token = token
+	}
+	if apiURL := getenv("SPLITWISE_API_URL"); apiURL != "" {
+		cfg.APIURL = apiURL
+	}
+	if cfg.Token == "" {
+		return nil, errors.New("no API token: set SPLITWISE_TOKEN or add a token to " + path)
+	}
+	return cfg, nil
+}
@@ -0,0 +1,165 @@
+// Command splitwise is a command-line client for the splitwise API.
+//
+// Usage:
+//
+//	splitwise [-json] [-config path] <command> [arguments]
+//
+// The commands are:
+//
+//	whoami                          show the current user
+//	groups list                     list groups
+//	groups show <id>                show a group and its members
+//	groups create -name <name>      create a group
+//	friends list                    list friends and balances
+//	friends add -email <email>      add a friend
+//	expenses list                   list expenses
+//	expenses add -cost <cost>       add an expense split equally within a group
+//	expenses delete <id>            delete an expense
+//	expenses undelete <id>          restore a deleted expense
+//	comments list <expense-id>      list the comments on an expense
+//	comments add <expense-id> <text>
+//	                                comment on an expense
+//
+// The API token is read from the SPLITWISE_TOKEN environment variable, or the "token"
+// field of the configuration file. The configuration file defaults to
+// $XDG_CONFIG_HOME/splitwise/config.json and may be set with SPLITWISE_CONFIG.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+	"golang.org/x/oauth2"
+)
+
+// app is the environment in which a command runs.
+type app struct {
+	client *splitwise.Client
+	out    *printer
+}
+
+type command struct {
+	name  string
+	usage string
+	run   func(ctx context.Context, a *app, args []string) error
+}
+
+var commands []command
+
+func init() {
+	commands = []command{
+		{"whoami", "", whoami},
+		{"groups list", "", groupsList},
+		{"groups show", "<id>", groupsShow},
+		{"groups create", "-name <name> [-type <type>] [-simplify] [-member <user-id>]...", groupsCreate},
+		{"friends list", "", friendsList},
+		{"friends add", "-email <email> [-first <name>] [-last <name>]", friendsAdd},
+		{"expenses list", "[-limit <n>] [-after <date>] [-before <date>]", expensesList},
+		{"expenses add", "-cost <cost> -description <text> -group <id> [-currency <code>] [-date <date>] [-details <text>]", expensesAdd},
+		{"expenses delete", "<id>", expensesDelete},
+		{"expenses undelete", "<id>", expensesUndelete},
+		{"comments list", "<expense-id>", commentsList},
+		{"comments add", "<expense-id> <text>", commentsAdd},
+	}
+}
+
+func main() {
+	os.Exit(run(context.Background(), os.Args[1:], os.Getenv, os.Stdout, os.Stderr, nil))
+}
+
+// run executes the CLI, returning its exit code. If httpClient is nil, requests are
+// authenticated with the configured token.
+func run(
+	ctx context.Context,
+	args []string,
+	getenv func(string) string,
+	stdout, stderr io.Writer,
+	httpClient splitwise.HTTPClient,
+) int {
+	fs := flag.NewFlagSet("splitwise", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	jsonOutput := fs.Bool("json", false, "write output as JSON")
+	configPath := fs.String("config", "", "path to the configuration file")
+	fs.Usage = func() { usage(stderr) }
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	cmd, rest, ok := findCommand(fs.Args())
+	if !ok {
+		usage(stderr)
+		return 2
+	}
+
+	path, explicit := *configPath, *configPath != ""
+	if !explicit {
+		path = defaultConfigPath(getenv)
+	}
+	cfg, err := loadConfig(path, explicit, getenv)
+	if err != nil {
+		fmt.Fprintf(stderr, "splitwise: %s\n", err)
+		return 1
+	}
+	if httpClient == nil {
+		httpClient = oauth2.NewClient(ctx, oauth2.StaticTokenSource(&oauth2.Token{AccessToken: cfg.Token}))
+	}
+	var opts []splitwise.Option
+	if cfg.APIURL != "" {
+		u, err := url.Parse(cfg.APIURL)
+		if err != nil {
+			fmt.Fprintf(stderr, "splitwise: invalid api url: %s\n", err)
+			return 1
+		}
+		opts = append(opts, splitwise.WithBaseURL(u))
+	}
+
+	a := &app{
+		client: splitwise.NewClient(httpClient, opts...),
+		out:    &printer{w: stdout, json: *jsonOutput},
+	}
+	if err := cmd.run(ctx, a, rest); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 2
+		}
+		fmt.Fprintf(stderr, "splitwise %s: %s\n", cmd.name, err)
+		return 1
+	}
+	return 0
+}
+
+// findCommand returns the command named by the leading arguments, and the remaining
+// arguments.
+func findCommand(args []string) (command, []string, bool) {
+	for _, cmd := range commands {
+		words := strings.Fields(cmd.name)
+		if len(args) < len(words) {
+			continue
+		}
+		if strings.Join(args[:len(words)], " ") == cmd.name {
+			return cmd, args[len(words):], true
+		}
+	}
+	return command{}, nil, false
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: splitwise [-json] [-config path] <command> [arguments]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "commands:")
+	names := make([]string, len(commands))
+	for i, cmd := range commands {
+		names[i] = fmt.Sprintf("  %s %s", cmd.name, cmd.usage)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintln(w, strings.TrimRight(name, " "))
+	}
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// responses are the canned responses of the fake server, keyed by method and endpoint.
+var responses = map[string]string{
+	"GET /get_current_user": `{"user": {
+		"id": 1, "first_name": "Ada", "last_name": "Lovelace",
+		"email": "ada@example.com", "registration_status": "confirmed", "default_currency": "GBP"
+	}}`,
+	"GET /get_groups": `{"groups": [
+		{"id": 10, "name": "Flat", "group_type": "apartment", "members": [{"id": 1}, {"id": 2}]},
+		{"id": 11, "name": "Paris", "group_type": "trip", "members": [{"id": 1}]}
+	]}`,
+	"GET /get_group/10": `{"group": {
+		"id": 10, "name": "Flat", "group_type": "apartment", "simplify_by_default": true,
+		"members": [
+			{"id": 1, "first_name": "Ada", "last_name": "Lovelace", "balance": [{"currency_code": "GBP", "amount": "12.50"}]},
+			{"id": 2, "first_name": "Grace", "last_name": "Hopper", "balance": [{"currency_code": "GBP", "amount": "-12.50"}]}
+		]
+	}}`,
+	"POST /create_group": `{"group": {"id": 12, "name": "Allotment", "group_type": "other"}}`,
+	"GET /get_friends": `{"friends": [
+		{"id": 2, "first_name": "Grace", "last_name": "Hopper", "balance": [{"currency_code": "GBP", "amount": "-12.50"}]},
+		{"id": 3, "first_name": "Alan", "last_name": "Turing", "balance": []}
+	]}`,
+	"POST /create_friend": `{"friend": {"id": 4, "first_name": "Edsger", "last_name": "Dijkstra"}}`,
+	"GET /get_expenses": `{"expenses": [
+		{"id": 100, "group_id": 10, "date": "2021-03-01T00:00:00Z", "description": "Groceries",
+		 "cost": "25.00", "currency_code": "GBP", "category": {"id": 12, "name": "Groceries"}},
+		{"id": 101, "group_id": 10, "date": "2021-03-04T00:00:00Z", "description": "Electricity",
+		 "cost": "60.00", "currency_code": "GBP", "category": {"id": 5, "name": "Electricity"},
+		 "deleted_at": "2021-03-05T10:00:00Z"}
+	]}`,
+	"POST /create_expense": `{"expense": {
+		"id": 102, "group_id": 10, "date": "2021-03-06T00:00:00Z", "description": "Pizza",
+		"cost": "18.00", "currency_code": "GBP"
+	}}`,
+	"POST /delete_expense/101":   `{"success": true}`,
+	"POST /undelete_expense/101": `{"success": true}`,
+	"GET /get_comments": `{"comments": [
+		{"id": 7, "content": "Who ate the last slice?", "user": {"id": 2, "first_name": "Grace", "last_name": "Hopper"}}
+	]}`,
+	"POST /create_comment": `{"comment": {"id": 8, "content": "Not me"}}`,
+}
+
+func newFakeServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		endpoint := strings.TrimPrefix(r.URL.Path, "/api/v3.0")
+		body, ok := responses[r.Method+" "+endpoint]
+		if !ok {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		rw.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestCommands(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{"whoami", []string{"whoami"}},
+		{"whoami_json", []string{"-json", "whoami"}},
+		{"groups_list", []string{"groups", "list"}},
+		{"groups_list_json", []string{"-json", "groups", "list"}},
+		{"groups_show", []string{"groups", "show", "10"}},
+		{"groups_create", []string{"groups", "create", "-name", "Allotment", "-member", "2"}},
+		{"friends_list", []string{"friends", "list"}},
+		{"friends_add", []string{"friends", "add", "-email", "edsger@example.com", "-first", "Edsger"}},
+		{"expenses_list", []string{"expenses", "list"}},
+		{"expenses_list_json", []string{"-json", "expenses", "list", "-after", "2021-03-01"}},
+		{"expenses_add", []string{"expenses", "add", "-cost", "18.00", "-description", "Pizza", "-group", "10"}},
+		{"expenses_delete", []string{"expenses", "delete", "101"}},
+		{"expenses_undelete_json", []string{"-json", "expenses", "undelete", "101"}},
+		{"comments_list", []string{"comments", "list", "100"}},
+		{"comments_add", []string{"comments", "add", "100", "Not", "me"}},
+	}
+
+	server := newFakeServer(t)
+	env := map[string]string{
+		"SPLITWISE_CONFIG":  filepath.Join(t.TempDir(), "missing.json"),
+		"SPLITWISE_TOKEN":   "token",
+		"SPLITWISE_API_URL": server.URL + "/api/v3.0/",
+	}
+	getenv := func(key string) string { return env[key] }
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(context.Background(), tt.args, getenv, &stdout, &stderr, server.Client())
+			if code != 0 {
+				t.Fatalf("exited with %d: %s", code, stderr.String())
+			}
+			golden := filepath.Join("testdata", tt.name+".golden")
+			if *update {
+				if err := ioutil.WriteFile(golden, stdout.Bytes(), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			expected, err := ioutil.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if stdout.String() != string(expected) {
+				t.Errorf("output does not match %s:\n%s", golden, stdout.String())
+			}
+		})
+	}
+}
+
+func TestConfigFile(t *testing.T) {
+	server := newFakeServer(t)
+	path := filepath.Join(t.TempDir(), "config.json")
+	config := `{"token": "token", "api_url": "` + server.URL + `/api/v3.0/"}`
+	if err := ioutil.WriteFile(path, []byte(config), 0600); err != nil {
+		t.Fatal(err)
+	}
+	getenv := func(string) string { return "" }
+
+	var stdout, stderr bytes.Buffer
+	code := run(context.Background(), []string{"-config", path, "whoami"}, getenv, &stdout, &stderr, server.Client())
+	if code != 0 {
+		t.Fatalf("exited with %d: %s", code, stderr.String())
+	}
+	if !strings.Contains(stdout.String(), "ada@example.com") {
+		t.Errorf("unexpected output: %s", stdout.String())
+	}
+}
+
+func TestErrors(t *testing.T) {
+	server := newFakeServer(t)
+	tests := []struct {
+		name string
+		args []string
+		env  map[string]string
+		code int
+		err  string
+	}{
+		{"unknown command", []string{"frobnicate"}, nil, 2, "usage:"},
+		{"missing token", []string{"whoami"}, map[string]string{}, 1, "token"},
+		{"missing argument", []string{"groups", "show"}, nil, 1, "expected a group id"},
+		{"not found", []string{"groups", "show", "99"}, nil, 1, "404"},
+		{"unknown group type", []string{"groups", "create", "-name", "Flat", "-type", "castle"}, nil, 1, "unknown group type"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := tt.env
+			if env == nil {
+				env = map[string]string{
+					"SPLITWISE_TOKEN":   "token",
+					"SPLITWISE_API_URL": server.URL + "/api/v3.0/",
+				}
+			}
+			env["SPLITWISE_CONFIG"] = filepath.Join(t.TempDir(), "missing.json")
+			getenv := func(key string) string { return env[key] }
+
+			var stdout, stderr bytes.Buffer
+			code := run(context.Background(), tt.args, getenv, &stdout, &stderr, server.Client())
+			if code != tt.code {
+				t.Errorf("expected exit code %d, got %d", tt.code, code)
+			}
+			if !strings.Contains(stderr.String(), tt.err) {
+				t.Errorf("expected %q in stderr: %s", tt.err, stderr.String())
+			}
+		})
+	}
+}
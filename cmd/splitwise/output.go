@@ -0,0 +1,61 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+)
+
+// printer writes the results of commands as tables or JSON.
+type printer struct {
+	w    io.Writer
+	json bool
+}
+
+// print writes v as JSON, or calls table to write it as a table.
+func (p *printer) print(v interface{}, table func(tw *tabwriter.Writer)) error {
+	if p.json {
+		enc := json.NewEncoder(p.w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+	tw := tabwriter.NewWriter(p.w, 0, 4, 2, ' ', 0)
+	table(tw)
+	return tw.Flush()
+}
+
+// message writes a confirmation, or v as JSON.
+func (p *printer) message(v interface{}, format string, args ...interface{}) error {
+	if p.json {
+		return p.print(v, nil)
+	}
+	_, err := fmt.Fprintf(p.w, format+"\n", args...)
+	return err
+}
+
+func row(tw *tabwriter.Writer, cols ...interface{}) {
+	strs := make([]string, len(cols))
+	for i, c := range cols {
+		strs[i] = fmt.Sprint(c)
+	}
+	fmt.Fprintln(tw, strings.Join(strs, "\t"))
+}
+
+func fullName(first, last string) string {
+	return strings.TrimSpace(first + " " + last)
+}
+
+func formatBalances(balances []splitwise.Balance) string {
+	var parts []string
+	for _, b := range balances {
+		parts = append(parts, b.Amount+" "+b.CurrencyCode)
+	}
+	if len(parts) == 0 {
+		return "settled up"
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,291 @@
+package splitwise
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DecodeWarningKind is the kind of a DecodeWarning.
+type DecodeWarningKind int
+
+const (
+	// UnknownField is a field of a response with no corresponding field in the result.
+	UnknownField DecodeWarningKind = iota + 1
+	// TypeMismatch is a value of a response that cannot be decoded into the type of
+	// its field in the result.
+	TypeMismatch
+)
+
+func (k DecodeWarningKind) String() string {
+	switch k {
+	case UnknownField:
+		return "unknown field"
+	case TypeMismatch:
+		return "type mismatch"
+	}
+	return "DecodeWarningKind(" + strconv.Itoa(int(k)) + ")"
+}
+
+// DecodeWarning describes a difference between a response and the type it was decoded
+// into, found when strict decoding is enabled.
+type DecodeWarning struct {
+	Kind DecodeWarningKind
+	// Path locates the value in the response, e.g. "expense.users[0].user.email".
+	Path string
+	// Value is the JSON type of the value, e.g. "string" or "object".
+	Value string
+	// Type is the Go type the value was decoded into, if the field is known.
+	Type string
+	// Err holds the error returned by the UnmarshalJSON or UnmarshalText method of
+	// Type, if it rejected the value.
+	Err error
+}
+
+func (w DecodeWarning) String() string {
+	switch {
+	case w.Kind == UnknownField:
+		return fmt.Sprintf("%s: unknown field", w.Path)
+	case w.Err != nil:
+		return fmt.Sprintf("%s: cannot decode %s into %s: %s", w.Path, w.Value, w.Type, w.Err)
+	}
+	return fmt.Sprintf("%s: cannot decode %s into %s", w.Path, w.Value, w.Type)
+}
+
+// WithStrictDecoding checks every response against the type it is decoded into, and
+// calls report with the warnings found for each Operation that has any.
+//
+// Unknown fields and values of the wrong type are reported rather than failing the
+// call, so that changes to the API can be noticed, e.g. by contract tests, while the
+// rest of the response is still decoded. This includes values rejected by the
+// UnmarshalJSON method of their type, such as a malformed time, which are left unset.
+// Enum values unknown to this package, such as a new Registration, are decoded as is
+// and reported as type mismatches.
+// The warnings are also stored in Operation.DecodeWarnings, where they can be seen by
+// middleware.
+func WithStrictDecoding(report func(op *Operation, warnings []DecodeWarning)) Option {
+	return func(c *Client) {
+		c.reportDecodeWarnings = report
+	}
+}
+
+// checkStrict sets op.DecodeWarnings to the differences between op.RawResponse and the
+// type of op.Result.
+//
+// It returns op.RawResponse with the values rejected by the UnmarshalJSON method of
+// their type replaced by null, so that the rest of it can still be decoded.
+func (op *Operation) checkStrict() ([]byte, error) {
+	var v interface{}
+	if err := json.Unmarshal(op.RawResponse, &v); err != nil {
+		return nil, err
+	}
+	op.DecodeWarnings = nil
+	var rejected bool
+	checkValue(v, reflect.TypeOf(op.Result), "", func(w DecodeWarning) {
+		op.DecodeWarnings = append(op.DecodeWarnings, w)
+	}, func() {
+		rejected = true
+	})
+	if !rejected {
+		return op.RawResponse, nil
+	}
+	return json.Marshal(v)
+}
+
+var (
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	extraFielderType    = reflect.TypeOf((*extraFielder)(nil)).Elem()
+)
+
+// knower is implemented by the enums, which decode any string.
+type knower interface {
+	Known() bool
+}
+
+// checkValue reports the differences between the JSON value v, as decoded into an
+// interface{}, and the Go type t.
+//
+// Values that the UnmarshalJSON method of their type rejects are replaced by null in
+// their parent object or array, and reject is called.
+func checkValue(v interface{}, t reflect.Type, path string, warn func(DecodeWarning), reject func()) bool {
+	if v == nil {
+		// null leaves any value unchanged.
+		return false
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	mismatch := func(err error) {
+		warn(DecodeWarning{Kind: TypeMismatch, Path: path, Value: jsonType(v), Type: t.String(), Err: err})
+	}
+
+	// Types that decode themselves can only be checked by decoding the value. Models
+	// are the exception, as they decode themselves only to capture unknown fields.
+	ptr := reflect.PtrTo(t)
+	custom := ptr.Implements(jsonUnmarshalerType) || (ptr.Implements(textUnmarshalerType) && jsonType(v) == "string")
+	if custom && !ptr.Implements(extraFielderType) {
+		data, err := json.Marshal(v)
+		decoded := reflect.New(t)
+		if err == nil {
+			err = json.Unmarshal(data, decoded.Interface())
+		}
+		if err != nil {
+			mismatch(err)
+			reject()
+			return true
+		}
+		// Enums decode values added to the API without error, but they are drift all
+		// the same.
+		if k, ok := decoded.Elem().Interface().(knower); ok && !k.Known() {
+			mismatch(fmt.Errorf("unknown %s %s", t.Name(), data))
+		}
+		return false
+	}
+
+	switch t.Kind() {
+	case reflect.Interface:
+	case reflect.Struct:
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			mismatch(nil)
+			return false
+		}
+		for _, key := range sortedKeys(obj) {
+			val := obj[key]
+			fieldPath := key
+			if path != "" {
+				fieldPath = path + "." + key
+			}
+			ft, ok := fieldByJSONName(t, key)
+			if !ok {
+				warn(DecodeWarning{Kind: UnknownField, Path: fieldPath, Value: jsonType(val)})
+				continue
+			}
+			if checkValue(val, ft, fieldPath, warn, reject) {
+				obj[key] = nil
+			}
+		}
+	case reflect.Map:
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			mismatch(nil)
+			return false
+		}
+		for _, key := range sortedKeys(obj) {
+			if checkValue(obj[key], t.Elem(), fmt.Sprintf("%s[%q]", path, key), warn, reject) {
+				obj[key] = nil
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		arr, ok := v.([]interface{})
+		if !ok {
+			mismatch(nil)
+			return false
+		}
+		for i, val := range arr {
+			if checkValue(val, t.Elem(), fmt.Sprintf("%s[%d]", path, i), warn, reject) {
+				arr[i] = nil
+			}
+		}
+	case reflect.String:
+		if _, ok := v.(string); !ok {
+			mismatch(nil)
+		}
+	case reflect.Bool:
+		if _, ok := v.(bool); !ok {
+			mismatch(nil)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n, ok := v.(float64); !ok || n != math.Trunc(n) || overflows(t, n) {
+			mismatch(nil)
+		}
+	case reflect.Float32, reflect.Float64:
+		if _, ok := v.(float64); !ok {
+			mismatch(nil)
+		}
+	}
+	return false
+}
+
+// overflows reports whether the integer n cannot be represented by the integer type t.
+func overflows(t reflect.Type, n float64) bool {
+	v := reflect.New(t).Elem()
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.OverflowInt(int64(n))
+	}
+	return n < 0 || v.OverflowUint(uint64(n))
+}
+
+// fieldByJSONName returns the type of the field of the struct t that a JSON object key
+// is decoded into, following the rules of encoding/json.
+func fieldByJSONName(t reflect.Type, key string) (reflect.Type, bool) {
+	var folded reflect.Type
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				if found, ok := fieldByJSONName(ft, key); ok {
+					return found, true
+				}
+				continue
+			}
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		if name == key {
+			return f.Type, true
+		}
+		if folded == nil && strings.EqualFold(name, key) {
+			folded = f.Type
+		}
+	}
+	return folded, folded != nil
+}
+
+func sortedKeys(obj map[string]interface{}) []string {
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// jsonType returns the name of the JSON type of a value decoded into an interface{}.
+func jsonType(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
@@ -0,0 +1,124 @@
+package splitwise
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func strictClient(t *testing.T, body string, report func(*Operation, []DecodeWarning)) *Client {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	u, _ := url.Parse(server.URL)
+	var opts []Option
+	if report != nil {
+		opts = append(opts, WithStrictDecoding(report))
+	}
+	return NewClient(&testHTTPClient{u: u}, opts...)
+}
+
+func TestStrictDecoding(t *testing.T) {
+	body := `{"expense": {
+		"id": 1,
+		"cost": 12.5,
+		"description": "Dinner",
+		"repeats": false,
+		"users": [{"user_id": "2", "paid_share": "12.50", "user": {"id": 2, "nickname": "G"}}],
+		"category": {"id": 15, "name": "General", "icon": null}
+	}}`
+	var reported []DecodeWarning
+	var ops []string
+	client := strictClient(t, body, func(op *Operation, warnings []DecodeWarning) {
+		ops = append(ops, op.Name)
+		reported = append(reported, warnings...)
+	})
+
+	expense, err := client.GetExpense(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expense.ID != 1 || expense.Description != "Dinner" || len(expense.Users) != 1 || expense.Users[0].PaidShare != "12.50" {
+		t.Errorf("expected the rest of the response to be decoded, got %+v", expense)
+	}
+	want := []DecodeWarning{
+		{Kind: UnknownField, Path: "expense.category.icon", Value: "null"},
+		{Kind: TypeMismatch, Path: "expense.cost", Value: "number", Type: "string"},
+		{Kind: UnknownField, Path: "expense.repeats", Value: "boolean"},
+		{Kind: UnknownField, Path: "expense.users[0].user.nickname", Value: "string"},
+		{Kind: TypeMismatch, Path: "expense.users[0].user_id", Value: "string", Type: "int"},
+	}
+	if !reflect.DeepEqual(reported, want) {
+		t.Errorf("unexpected warnings:\ngot:  %+v\nwant: %+v", reported, want)
+	}
+	if !reflect.DeepEqual(ops, []string{"get_expense"}) {
+		t.Errorf("expected one report for get_expense, got %v", ops)
+	}
+	if got := want[1].String(); got != "expense.cost: cannot decode number into string" {
+		t.Errorf("unexpected string %q", got)
+	}
+}
+
+func TestStrictDecodingCustomTypes(t *testing.T) {
+	body := `{"user": {"id": 1, "registration_status": "unconfirmed", "notifications_read": "yesterday"}}`
+	var reported []DecodeWarning
+	client := strictClient(t, body, func(op *Operation, warnings []DecodeWarning) {
+		reported = append(reported, warnings...)
+	})
+	// Values rejected by UnmarshalJSON are reported and left unset.
+	user, err := client.GetCurrentUser(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if user.ID != 1 || user.NotificationsRead != nil {
+		t.Errorf("expected the rest of the user to be decoded, got %+v", user)
+	}
+	if len(reported) != 2 {
+		t.Fatalf("expected 2 warnings, got %v", reported)
+	}
+	for i, path := range []string{"user.notifications_read", "user.registration_status"} {
+		if w := reported[i]; w.Kind != TypeMismatch || w.Path != path || w.Err == nil {
+			t.Errorf("unexpected warning %+v", w)
+		}
+	}
+}
+
+func TestStrictDecodingClean(t *testing.T) {
+	body := `{"user": {"id": 1, "first_name": "Ada", "notifications_read": null, "picture": {"small": "s"}}}`
+	client := strictClient(t, body, func(op *Operation, warnings []DecodeWarning) {
+		t.Errorf("unexpected warnings %v", warnings)
+	})
+	if _, err := client.GetCurrentUser(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTypeMismatchWithoutStrictDecoding(t *testing.T) {
+	client := strictClient(t, `{"expense": {"id": 1, "cost": 12.5}}`, nil)
+	if _, err := client.GetExpense(context.Background(), 1); err == nil {
+		t.Fatal("expected a decode error")
+	}
+}
+
+func TestStrictDecodingUnknownEnum(t *testing.T) {
+	body := `{"group": {"id": 1, "group_type": "couple"}}`
+	var reported []DecodeWarning
+	client := strictClient(t, body, func(op *Operation, warnings []DecodeWarning) {
+		reported = append(reported, warnings...)
+	})
+	group, err := client.GetGroup(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("expected an unknown group type not to fail the call: %s", err)
+	}
+	if group.GroupType != "couple" {
+		t.Errorf("expected the unknown group type to be kept, got %q", group.GroupType)
+	}
+	if len(reported) != 1 || reported[0].Path != "group.group_type" || reported[0].Kind != TypeMismatch {
+		t.Errorf("unexpected warnings %v", reported)
+	}
+}
@@ -0,0 +1,165 @@
+package splitwise
+
+import (
+	"encoding"
+	"encoding/json"
+)
+
+// The enums of the API are strings, so that values added to the API after this
+// version of the package are preserved as is rather than failing to decode. Known
+// reports whether a value is one defined here.
+//
+// As when they were ints, the zero value of each enum is its first constant, which
+// encodes as its name. Values should be parsed from their names with UnmarshalText
+// rather than converted, since e.g. GroupType("other") is not GroupTypeOther.
+
+// Registration is the registration status of a user.
+type Registration string
+
+const (
+	RegistrationDummy     Registration = ""
+	RegistrationConfirmed Registration = "confirmed"
+	RegistrationInvited   Registration = "invited"
+)
+
+// Known reports whether r is one of the registration statuses defined by this package.
+func (r Registration) Known() bool {
+	switch r {
+	case RegistrationDummy, RegistrationConfirmed, RegistrationInvited:
+		return true
+	}
+	return false
+}
+
+func (r Registration) String() string {
+	if r == RegistrationDummy {
+		return "dummy"
+	}
+	return string(r)
+}
+
+func (r Registration) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+func (r *Registration) UnmarshalText(text []byte) error {
+	*r = Registration(parseEnum(string(text), "dummy"))
+	return nil
+}
+
+func (r Registration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.String())
+}
+
+func (r *Registration) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, r)
+}
+
+// RepeatInterval is how often an expense repeats.
+type RepeatInterval string
+
+const (
+	RepeatNever       RepeatInterval = ""
+	RepeatWeekly      RepeatInterval = "weekly"
+	RepeatFortnightly RepeatInterval = "fortnightly"
+	RepeatMonthly     RepeatInterval = "monthly"
+	RepeatYearly      RepeatInterval = "yearly"
+)
+
+// Known reports whether ri is one of the repeat intervals defined by this package.
+func (ri RepeatInterval) Known() bool {
+	switch ri {
+	case RepeatNever, RepeatWeekly, RepeatFortnightly, RepeatMonthly, RepeatYearly:
+		return true
+	}
+	return false
+}
+
+func (ri RepeatInterval) String() string {
+	if ri == RepeatNever {
+		return "never"
+	}
+	return string(ri)
+}
+
+func (ri RepeatInterval) MarshalText() ([]byte, error) {
+	return []byte(ri.String()), nil
+}
+
+func (ri *RepeatInterval) UnmarshalText(text []byte) error {
+	*ri = RepeatInterval(parseEnum(string(text), "never"))
+	return nil
+}
+
+func (ri RepeatInterval) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ri.String())
+}
+
+func (ri *RepeatInterval) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, ri)
+}
+
+// GroupType is the kind of a group.
+type GroupType string
+
+const (
+	GroupTypeOther     GroupType = ""
+	GroupTypeApartment GroupType = "apartment"
+	GroupTypeHouse     GroupType = "house"
+	GroupTypeTrip      GroupType = "trip"
+)
+
+// Known reports whether gt is one of the group types defined by this package.
+func (gt GroupType) Known() bool {
+	switch gt {
+	case GroupTypeOther, GroupTypeApartment, GroupTypeHouse, GroupTypeTrip:
+		return true
+	}
+	return false
+}
+
+func (gt GroupType) String() string {
+	if gt == GroupTypeOther {
+		return "other"
+	}
+	return string(gt)
+}
+
+func (gt GroupType) MarshalText() ([]byte, error) {
+	return []byte(gt.String()), nil
+}
+
+func (gt *GroupType) UnmarshalText(text []byte) error {
+	*gt = GroupType(parseEnum(string(text), "other"))
+	return nil
+}
+
+func (gt GroupType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(gt.String())
+}
+
+func (gt *GroupType) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, gt)
+}
+
+// parseEnum returns the value of an enum with the given name, where zero is the name
+// of its zero value.
+func parseEnum(name, zero string) string {
+	if name == zero {
+		return ""
+	}
+	return name
+}
+
+// unmarshalEnum decodes the JSON string data into v with UnmarshalText, leaving it
+// unchanged if data is null as encoding/json does for strings.
+func unmarshalEnum(data []byte, v encoding.TextUnmarshaler) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(name))
+}
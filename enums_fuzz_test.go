@@ -0,0 +1,58 @@
+//go:build go1.18
+// +build go1.18
+
+package splitwise
+
+import (
+	"encoding/json"
+	"testing"
+	"unicode/utf8"
+)
+
+// FuzzEnums checks that any JSON string decodes into each enum without error, and
+// encodes back to the same string.
+//
+// The empty string is skipped, since it decodes to the zero value which encodes as
+// the name of the first constant.
+func FuzzEnums(f *testing.F) {
+	for _, seed := range []string{"dummy", "never", "other", "monthly", "apartment", "unknown", "é"} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		if s == "" || !utf8.ValidString(s) {
+			// encoding/json replaces invalid UTF-8, so it cannot round trip.
+			return
+		}
+		data, err := json.Marshal(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, v := range []enum{new(Registration), new(RepeatInterval), new(GroupType)} {
+			if err := json.Unmarshal(data, v); err != nil {
+				t.Fatalf("%T: unmarshal %s: %s", v, data, err)
+			}
+			if v.String() != s {
+				t.Errorf("%T: got %q, want %q", v, v.String(), s)
+			}
+			encoded, err := json.Marshal(v)
+			if err != nil || string(encoded) != string(data) {
+				t.Errorf("%T: MarshalJSON() = %s, %v, want %s", v, encoded, err, data)
+			}
+		}
+	})
+}
+
+// FuzzEnumJSON checks that decoding arbitrary JSON into the enums never panics.
+func FuzzEnumJSON(f *testing.F) {
+	for _, seed := range []string{`"trip"`, `null`, `1`, `{}`, `"\ud800"`} {
+		f.Add([]byte(seed))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for _, v := range []enum{new(Registration), new(RepeatInterval), new(GroupType)} {
+			if err := json.Unmarshal(data, v); err == nil {
+				_ = v.String()
+				_ = v.Known()
+			}
+		}
+	})
+}
@@ -0,0 +1,140 @@
+package splitwise
+
+import (
+	"encoding"
+	"encoding/json"
+	"testing"
+)
+
+// enum is implemented by pointers to each of the enum types.
+type enum interface {
+	json.Marshaler
+	json.Unmarshaler
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+	String() string
+	Known() bool
+}
+
+func TestEnums(t *testing.T) {
+	tests := []struct {
+		name  string
+		new   func() enum
+		json  string
+		want  enum
+		known bool
+	}{
+		{"registration dummy", func() enum { return new(Registration) }, `"dummy"`, ptrRegistration(RegistrationDummy), true},
+		{"registration confirmed", func() enum { return new(Registration) }, `"confirmed"`, ptrRegistration(RegistrationConfirmed), true},
+		{"registration invited", func() enum { return new(Registration) }, `"invited"`, ptrRegistration(RegistrationInvited), true},
+		{"registration unknown", func() enum { return new(Registration) }, `"pending"`, ptrRegistration(Registration("pending")), false},
+		{"repeat never", func() enum { return new(RepeatInterval) }, `"never"`, ptrRepeat(RepeatNever), true},
+		{"repeat weekly", func() enum { return new(RepeatInterval) }, `"weekly"`, ptrRepeat(RepeatWeekly), true},
+		{"repeat fortnightly", func() enum { return new(RepeatInterval) }, `"fortnightly"`, ptrRepeat(RepeatFortnightly), true},
+		{"repeat monthly", func() enum { return new(RepeatInterval) }, `"monthly"`, ptrRepeat(RepeatMonthly), true},
+		{"repeat yearly", func() enum { return new(RepeatInterval) }, `"yearly"`, ptrRepeat(RepeatYearly), true},
+		{"repeat unknown", func() enum { return new(RepeatInterval) }, `"daily"`, ptrRepeat(RepeatInterval("daily")), false},
+		{"group other", func() enum { return new(GroupType) }, `"other"`, ptrGroup(GroupTypeOther), true},
+		{"group apartment", func() enum { return new(GroupType) }, `"apartment"`, ptrGroup(GroupTypeApartment), true},
+		{"group house", func() enum { return new(GroupType) }, `"house"`, ptrGroup(GroupTypeHouse), true},
+		{"group trip", func() enum { return new(GroupType) }, `"trip"`, ptrGroup(GroupTypeTrip), true},
+		{"group unknown", func() enum { return new(GroupType) }, `"couple"`, ptrGroup(GroupType("couple")), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.new()
+			if err := json.Unmarshal([]byte(tt.json), got); err != nil {
+				t.Fatalf("unmarshal: %s", err)
+			}
+			if got.String() != tt.want.String() {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+			if got.Known() != tt.known {
+				t.Errorf("Known() = %v, want %v", got.Known(), tt.known)
+			}
+			encoded, err := json.Marshal(got)
+			if err != nil || string(encoded) != tt.json {
+				t.Errorf("MarshalJSON() = %s, %v, want %s", encoded, err, tt.json)
+			}
+			text, err := got.MarshalText()
+			if err != nil || string(text) != got.String() {
+				t.Errorf("MarshalText() = %s, %v, want %s", text, err, got)
+			}
+			fromText := tt.new()
+			if err := fromText.UnmarshalText(text); err != nil || fromText.String() != got.String() {
+				t.Errorf("UnmarshalText(%s) = %q, %v", text, fromText, err)
+			}
+		})
+	}
+}
+
+func ptrRegistration(r Registration) *Registration { return &r }
+func ptrRepeat(ri RepeatInterval) *RepeatInterval  { return &ri }
+func ptrGroup(gt GroupType) *GroupType             { return &gt }
+
+func TestEnumNull(t *testing.T) {
+	gt := GroupTypeTrip
+	if err := json.Unmarshal([]byte("null"), &gt); err != nil || gt != GroupTypeTrip {
+		t.Errorf("expected null to leave the value unchanged, got %q, %v", gt, err)
+	}
+}
+
+func TestEnumNotString(t *testing.T) {
+	for _, data := range []string{`1`, `true`, `{}`, `[]`} {
+		var ri RepeatInterval
+		if err := json.Unmarshal([]byte(data), &ri); err == nil {
+			t.Errorf("expected an error decoding %s", data)
+		}
+	}
+}
+
+func TestUnknownGroupType(t *testing.T) {
+	var res struct {
+		Groups []Group `json:"groups"`
+	}
+	data := `{"groups": [{"id": 1, "group_type": "couple"}, {"id": 2, "group_type": "trip"}]}`
+	if err := json.Unmarshal([]byte(data), &res); err != nil {
+		t.Fatalf("expected an unknown group type not to fail decoding: %s", err)
+	}
+	if len(res.Groups) != 2 || res.Groups[0].GroupType != "couple" || res.Groups[1].GroupType != GroupTypeTrip {
+		t.Errorf("unexpected groups %+v", res.Groups)
+	}
+}
+
+func TestEnumZeroValues(t *testing.T) {
+	var (
+		r  Registration
+		ri RepeatInterval
+		gt GroupType
+	)
+	if r != RegistrationDummy || ri != RepeatNever || gt != GroupTypeOther {
+		t.Errorf("expected the zero values to be the first constants, got %q, %q, %q", r, ri, gt)
+	}
+	for _, v := range []enum{&r, &ri, &gt} {
+		if !v.Known() {
+			t.Errorf("%T: expected the zero value to be known", v)
+		}
+		if err := json.Unmarshal([]byte(`""`), v); err != nil || !v.Known() {
+			t.Errorf("%T: expected an empty string to decode to the zero value, got %q, %v", v, v, err)
+		}
+	}
+	if r.String() != "dummy" || ri.String() != "never" || gt.String() != "other" {
+		t.Errorf("unexpected names %s, %s, %s", r, ri, gt)
+	}
+
+	rw := newRequest()
+	if err := encodeRequest(rw, &CreateGroupRequest{Name: "Flat"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := rw.Get("group_type"); got != "other" {
+		t.Errorf("expected the zero group type to be sent as other, got %q", got)
+	}
+}
+
+func TestEnumConversion(t *testing.T) {
+	var gt GroupType
+	gt.UnmarshalText([]byte("other"))
+	if gt != GroupTypeOther {
+		t.Errorf("expected other to parse to GroupTypeOther, got %q", gt)
+	}
+}
@@ -46,3 +46,21 @@ func (se *APIError) Error() string {
 	}
 	return ""
 }
+
+// apiErrorer is implemented by responses which may report an APIError in their body.
+type apiErrorer interface {
+	apiError() error
+}
+
+// errorsResponse is embedded within responses that may carry an "errors" field,
+// so that the errors can be surfaced by Client.do.
+type errorsResponse struct {
+	Errors APIError `json:"errors"`
+}
+
+func (r *errorsResponse) apiError() error {
+	if r.Errors.Len() > 0 {
+		return &r.Errors
+	}
+	return nil
+}
@@ -40,8 +40,8 @@ func SplitManually(users ...UserShare) SplitStrategy {
 
 type UserShare struct {
 	UserOption UserOption
-	PaidShare  string
-	OwedShare  string
+	PaidShare  string `json:"paid_share"`
+	OwedShare  string `json:"owed_share"`
 }
 
 type CreateExpenseRequest struct {
@@ -53,11 +53,17 @@ type CreateExpenseRequest struct {
 
 	// Optional parameters
 
+	// GroupID adds the expense to a group. It is implied when splitting equally.
+	GroupID        *int            `json:"group_id"`
 	Details        *string         `json:"details"`
 	Date           *time.Time      `json:"date"`
 	RepeatInterval *RepeatInterval `json:"repeat_interval"`
 	CurrencyCode   *string         `json:"currency_code"`
 	CategoryID     *int            `json:"category_id"`
+
+	// IdempotencyKey makes creation safe to retry. It may only contain ASCII letters,
+	// digits, '.', '_' and '-', and be at most 64 bytes long. See CreateExpense.
+	IdempotencyKey string `json:"-"`
 }
 
 type ExpenseUser struct {
@@ -69,26 +75,48 @@ type ExpenseUser struct {
 }
 
 type Expense struct {
-	ID          int           `json:"id"`
-	CreatedAt   time.Time     `json:"created_at"`
-	UpdatedAt   time.Time     `json:"updated_at"`
-	DeletedAt   *time.Time    `json:"deleted_at"`
-	Category    Category      `json:"category"`
-	Cost        string        `json:"cost"`
-	Description string        `json:"description"`
-	Users       []ExpenseUser `json:"users"`
+	extraFields
+
+	ID           int           `json:"id"`
+	GroupID      *int          `json:"group_id"`
+	Date         time.Time     `json:"date"`
+	CreatedAt    time.Time     `json:"created_at"`
+	UpdatedAt    time.Time     `json:"updated_at"`
+	DeletedAt    *time.Time    `json:"deleted_at"`
+	Category     Category      `json:"category"`
+	Cost         string        `json:"cost"`
+	CurrencyCode string        `json:"currency_code"`
+	Payment      bool          `json:"payment"`
+	Description  string        `json:"description"`
+	Details      string        `json:"details"`
+	Users        []ExpenseUser `json:"users"`
+	Repayments   []Repayment   `json:"repayments"`
+}
+
+// Repayment is a debt between two users resulting from an expense.
+type Repayment struct {
+	From   int    `json:"from"`
+	To     int    `json:"to"`
+	Amount string `json:"amount"`
 }
 
 type GetExpensesRequest struct {
-	DatedAfter    *time.Time `json:"dated_after"`
-	DatedBefore   *time.Time `json:"dated_before"`
+	// GroupID limits the expenses to those in the given group.
+	GroupID *int `json:"group_id"`
+	// FriendID limits the expenses to those shared with the given friend.
+	FriendID *int `json:"friend_id"`
+
+	DatedAfter    *time.Time `json:"dated_after" layout:"2006-01-02"`
+	DatedBefore   *time.Time `json:"dated_before" layout:"2006-01-02"`
 	UpdatedAfter  *time.Time `json:"updated_after"`
 	UpdatedBefore *time.Time `json:"updated_before"`
-	Limit         int        `json:"limit"`
-	Offset        int        `json:"offset"`
+	Limit         int        `json:"limit,omitempty"`
+	Offset        int        `json:"offset,omitempty"`
 }
 
 type Comment struct {
+	extraFields
+
 	ID           int        `json:"id"`
 	Content      string     `json:"content"`
 	CommentType  string     `json:"comment_type"`
@@ -99,41 +127,50 @@ type Comment struct {
 	User         User       `json:"user"`
 }
 
+// CreateExpense creates a new expense.
+//
+// If req.IdempotencyKey is set, the key is recorded in the details of the expense and
+// recently updated expenses are first searched for one with the same key. If one is
+// found it is returned instead of creating a duplicate, so that a request which failed
+// with an unknown outcome, such as a timeout, can be safely retried. An error is
+// returned without making any request if the key is invalid.
 func (c *Client) CreateExpense(ctx context.Context, req CreateExpenseRequest) (*Expense, error) {
+	if req.IdempotencyKey != "" {
+		if err := checkIdempotencyKey(req.IdempotencyKey); err != nil {
+			return nil, err
+		}
+		existing, err := c.FindExpenseByIdempotencyKey(ctx, req.IdempotencyKey)
+		if err == nil {
+			return existing, nil
+		}
+		if !errors.Is(err, ErrNotFound) {
+			return nil, err
+		}
+		var details string
+		if req.Details != nil {
+			details = *req.Details
+		}
+		details = withIdempotencyKey(details, req.IdempotencyKey)
+		req.Details = &details
+	}
 	var res struct {
-		Expense Expense  `json:"expense"`
-		Errors  APIError `json:"errors"`
+		Expense Expense `json:"expense"`
+		errorsResponse
 	}
 	rw := newRequest()
-	rw.Str("cost", req.Cost)
-	rw.Str("description", req.Description)
-	rw.Bool("payment", req.Payment)
-	if req.Details != nil {
-		rw.Str("details", *req.Details)
-	}
-	if req.RepeatInterval != nil {
-		rw.Set("repeat_interval", req.RepeatInterval.String())
-	}
-	if req.CurrencyCode != nil {
-		rw.Str("currency_code", *req.CurrencyCode)
-	}
-	if req.CategoryID != nil {
-		rw.Int("category_id", *req.CategoryID)
+	if err := encodeRequest(rw, &req); err != nil {
+		return nil, err
 	}
-	req.SplitStrategy.prepareRequest(rw)
 	err := c.do(
 		ctx,
 		http.MethodPost,
 		&url.URL{Path: "create_expense"},
-		rw.Values,
+		&rw,
 		&res,
 	)
 	if err != nil {
 		return nil, err
 	}
-	if res.Errors.Len() > 0 {
-		return nil, &res.Errors
-	}
 	return &res.Expense, nil
 }
 
@@ -146,36 +183,21 @@ func (c *Client) GetExpense(ctx context.Context, id int) (*Expense, error) {
 }
 
 func (c *Client) GetExpenses(ctx context.Context, req *GetExpensesRequest) ([]Expense, error) {
-	values := make(url.Values)
-	if req.DatedAfter != nil {
-		values.Add("dated_after", req.DatedAfter.Format("2006-01-02"))
-	}
-	if req.DatedBefore != nil {
-		values.Add("dated_before", req.DatedBefore.Format("2006-01-02"))
-	}
-	if req.UpdatedBefore != nil {
-		values.Add("updated_before", req.UpdatedBefore.Format("2006-01-02"))
-	}
-	if req.UpdatedAfter != nil {
-		values.Add("updated_after", req.UpdatedAfter.Format("2006-01-02"))
-	}
-	if req.Offset > 0 {
-		values.Add("offset", strconv.Itoa(req.Offset))
-	}
-	if req.Limit > 0 {
-		values.Add("limit", strconv.Itoa(req.Limit))
+	rw := newRequest()
+	if err := encodeRequest(rw, req); err != nil {
+		return nil, err
 	}
 	var res struct {
 		Expenses []Expense `json:"expenses"`
 	}
 	u := &url.URL{
 		Path:     "get_expenses",
-		RawQuery: values.Encode(),
+		RawQuery: rw.Values.Encode(),
 	}
 	if err := c.do(ctx, http.MethodGet, u, nil, &res); err != nil {
 		return nil, err
 	}
-	req.Offset = len(res.Expenses)
+	req.Offset += len(res.Expenses)
 	return res.Expenses, nil
 }
 
@@ -209,55 +231,49 @@ func (c *Client) GetComments(ctx context.Context, expenseID int) ([]Comment, err
 	var res struct {
 		Comments []Comment `json:"comments"`
 	}
-	err := c.get(ctx, "get_comments", &res)
+	u := &url.URL{
+		Path:     "get_comments",
+		RawQuery: url.Values{"expense_id": []string{strconv.Itoa(expenseID)}}.Encode(),
+	}
+	err := c.do(ctx, http.MethodGet, u, nil, &res)
 	return res.Comments, err
 }
 
 func (c *Client) CreateComment(ctx context.Context, expenseID int, content string) (*Comment, error) {
-	values := url.Values{
-		"expense_id": []string{strconv.Itoa(expenseID)},
-		"content":    []string{content},
-	}
+	rw := newRequest()
+	rw.Int("expense_id", expenseID)
+	rw.Str("content", content)
 	var res struct {
-		Comment Comment  `json:"comment"`
-		Errors  APIError `json:"errors"`
+		Comment Comment `json:"comment"`
+		errorsResponse
 	}
-	err := c.do(ctx, http.MethodPost, &url.URL{Path: "create_comment"}, values, &res)
+	err := c.do(ctx, http.MethodPost, &url.URL{Path: "create_comment"}, &rw, &res)
 	if err != nil {
 		return nil, err
 	}
-	if res.Errors.Len() > 0 {
-		return nil, &res.Errors
-	}
 	return &res.Comment, nil
 }
 
 func (c *Client) GetComment(ctx context.Context, id int) (*Comment, error) {
 	var res struct {
-		Comment Comment  `json:"comment"`
-		Errors  APIError `json:"errors"`
+		Comment Comment `json:"comment"`
+		errorsResponse
 	}
 	err := c.get(ctx, fmt.Sprintf("get_comment/%d", id), &res)
 	if err != nil {
 		return nil, err
 	}
-	if res.Errors.Len() > 0 {
-		return nil, &res.Errors
-	}
 	return &res.Comment, nil
 }
 
 func (c *Client) DeleteComment(ctx context.Context, id int) (*Comment, error) {
 	var res struct {
-		Comment Comment  `json:"comment"`
-		Errors  APIError `json:"errors"`
+		Comment Comment `json:"comment"`
+		errorsResponse
 	}
 	err := c.get(ctx, fmt.Sprintf("delete_comment/%d", id), &res)
 	if err != nil {
 		return nil, err
 	}
-	if res.Errors.Len() > 0 {
-		return nil, &res.Errors
-	}
 	return &res.Comment, nil
 }
@@ -0,0 +1,242 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"math/big"
+	"sort"
+	"strings"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+)
+
+// Column is a column written by WriteCSV.
+type Column int
+
+const (
+	ColumnDate Column = iota
+	ColumnDescription
+	ColumnCategory
+	ColumnCost
+	ColumnCurrency
+	ColumnGroup
+	ColumnPayers
+	// ColumnOwedShares expands to one column per user, holding their owed share.
+	ColumnOwedShares
+	// ColumnNetBalances expands to one column per user, holding their net balance.
+	ColumnNetBalances
+)
+
+// DefaultColumns are the columns written by WriteCSV if none are given.
+var DefaultColumns = []Column{
+	ColumnDate,
+	ColumnDescription,
+	ColumnCategory,
+	ColumnCost,
+	ColumnCurrency,
+	ColumnGroup,
+	ColumnPayers,
+	ColumnOwedShares,
+}
+
+// splitwiseColumns matches the layout of the CSV export of the splitwise website.
+var splitwiseColumns = []Column{
+	ColumnDate,
+	ColumnDescription,
+	ColumnCategory,
+	ColumnCost,
+	ColumnCurrency,
+	ColumnNetBalances,
+}
+
+// CSVOptions configures WriteCSV.
+type CSVOptions struct {
+	// Columns are the columns to write. If nil, DefaultColumns are written.
+	Columns []Column
+	// Groups are used to resolve the name of the group of each expense.
+	Groups []splitwise.Group
+	// DateFormat is the layout of dates. It defaults to "2006-01-02".
+	DateFormat string
+	// IncludeDeleted writes deleted expenses, which are skipped by default.
+	IncludeDeleted bool
+	// SplitwiseLayout writes the same layout as the CSV export of the splitwise
+	// website, ignoring Columns: one net balance column per user and final rows of
+	// total balances, one per currency.
+	SplitwiseLayout bool
+}
+
+// WriteCSV writes expenses as CSV to w, with a header row.
+//
+// Expenses may be fetched with Client.GetExpenses, or with Client.Expenses followed by
+// ExpenseIterator.All.
+func WriteCSV(w io.Writer, expenses []splitwise.Expense, opts CSVOptions) error {
+	columns := opts.Columns
+	if columns == nil {
+		columns = DefaultColumns
+	}
+	if opts.SplitwiseLayout {
+		columns = splitwiseColumns
+	}
+	dateFormat := opts.DateFormat
+	if dateFormat == "" {
+		dateFormat = "2006-01-02"
+	}
+	groups := make(map[int]string, len(opts.Groups))
+	for _, g := range opts.Groups {
+		groups[g.ID] = g.Name
+	}
+	var included []splitwise.Expense
+	for _, e := range expenses {
+		if e.DeletedAt == nil || opts.IncludeDeleted {
+			included = append(included, e)
+		}
+	}
+	users := participants(included)
+
+	cw := csv.NewWriter(w)
+	var header []string
+	for _, col := range columns {
+		switch col {
+		case ColumnOwedShares, ColumnNetBalances:
+			for _, u := range users {
+				header = append(header, userName(u))
+			}
+		default:
+			header = append(header, columnNames[col])
+		}
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	// totals holds the total balance of each user by currency, since amounts in
+	// different currencies cannot be added.
+	totals := make(map[string]map[int]*big.Rat)
+	for _, e := range included {
+		var row []string
+		for _, col := range columns {
+			switch col {
+			case ColumnDate:
+				row = append(row, e.Date.Format(dateFormat))
+			case ColumnDescription:
+				row = append(row, e.Description)
+			case ColumnCategory:
+				row = append(row, e.Category.Name)
+			case ColumnCost:
+				row = append(row, e.Cost)
+			case ColumnCurrency:
+				row = append(row, e.CurrencyCode)
+			case ColumnGroup:
+				var name string
+				if e.GroupID != nil {
+					name = groups[*e.GroupID]
+				}
+				row = append(row, name)
+			case ColumnPayers:
+				row = append(row, payers(e))
+			case ColumnOwedShares:
+				for _, u := range users {
+					share, _ := shareOf(e, u.ID)
+					row = append(row, share.OwedShare)
+				}
+			case ColumnNetBalances:
+				for _, u := range users {
+					share, ok := shareOf(e, u.ID)
+					balance := netBalance(share)
+					if ok {
+						byUser := totals[e.CurrencyCode]
+						if byUser == nil {
+							byUser = make(map[int]*big.Rat)
+							totals[e.CurrencyCode] = byUser
+						}
+						if byUser[u.ID] == nil {
+							byUser[u.ID] = new(big.Rat)
+						}
+						byUser[u.ID].Add(byUser[u.ID], balance)
+					}
+					row = append(row, balance.FloatString(2))
+				}
+			}
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	if opts.SplitwiseLayout {
+		currencies := make([]string, 0, len(totals))
+		for currency := range totals {
+			currencies = append(currencies, currency)
+		}
+		sort.Strings(currencies)
+		if len(currencies) == 0 {
+			currencies = []string{""}
+		}
+		for _, currency := range currencies {
+			row := make([]string, len(header))
+			row[1] = "Total balance"
+			row[4] = currency
+			for i, u := range users {
+				total := totals[currency][u.ID]
+				if total == nil {
+					total = new(big.Rat)
+				}
+				row[len(header)-len(users)+i] = total.FloatString(2)
+			}
+			if err := cw.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+var columnNames = map[Column]string{
+	ColumnDate:        "Date",
+	ColumnDescription: "Description",
+	ColumnCategory:    "Category",
+	ColumnCost:        "Cost",
+	ColumnCurrency:    "Currency",
+	ColumnGroup:       "Group",
+	ColumnPayers:      "Paid by",
+}
+
+// payers lists the users who paid towards an expense, with their amounts if more than
+// one user paid.
+func payers(e splitwise.Expense) string {
+	var paid []splitwise.ExpenseUser
+	for _, eu := range e.Users {
+		if amount, ok := parseAmount(eu.PaidShare); ok && amount.Sign() > 0 {
+			paid = append(paid, eu)
+		}
+	}
+	if len(paid) == 1 {
+		return userName(paid[0].User)
+	}
+	names := make([]string, len(paid))
+	for i, eu := range paid {
+		names[i] = userName(eu.User) + " (" + eu.PaidShare + ")"
+	}
+	return strings.Join(names, "; ")
+}
+
+// netBalance is the amount a user paid minus the amount they owe.
+func netBalance(eu splitwise.ExpenseUser) *big.Rat {
+	paid, _ := parseAmount(eu.PaidShare)
+	owed, _ := parseAmount(eu.OwedShare)
+	return new(big.Rat).Sub(paid, owed)
+}
+
+// parseAmount parses a decimal amount such as "12.50", treating an empty amount
+// as zero.
+func parseAmount(s string) (*big.Rat, bool) {
+	if s == "" {
+		return new(big.Rat), true
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return new(big.Rat), false
+	}
+	return r, true
+}
@@ -0,0 +1,106 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+)
+
+var (
+	ada   = splitwise.User{ID: 1, FirstName: "Ada", LastName: "Lovelace"}
+	grace = splitwise.User{ID: 2, FirstName: "Grace", LastName: "Hopper"}
+)
+
+func intPtr(i int) *int { return &i }
+
+func testExpenses() []splitwise.Expense {
+	deleted := time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC)
+	return []splitwise.Expense{
+		{
+			ID:           1,
+			GroupID:      intPtr(10),
+			Date:         time.Date(2021, 3, 1, 18, 0, 0, 0, time.UTC),
+			Description:  "Groceries",
+			Category:     splitwise.Category{Name: "Food"},
+			Cost:         "30.00",
+			CurrencyCode: "USD",
+			Users: []splitwise.ExpenseUser{
+				{UserID: 1, User: ada, PaidShare: "30.00", OwedShare: "15.00"},
+				{UserID: 2, User: grace, PaidShare: "0.00", OwedShare: "15.00"},
+			},
+		},
+		{
+			ID:           2,
+			Date:         time.Date(2021, 3, 2, 9, 0, 0, 0, time.UTC),
+			Description:  "Taxi",
+			Category:     splitwise.Category{Name: "Transport"},
+			Cost:         "12.00",
+			CurrencyCode: "EUR",
+			Users: []splitwise.ExpenseUser{
+				{UserID: 1, User: ada, PaidShare: "6.00", OwedShare: "4.00"},
+				{UserID: 2, User: grace, PaidShare: "6.00", OwedShare: "8.00"},
+			},
+		},
+		{
+			ID:          3,
+			Date:        time.Date(2021, 3, 3, 9, 0, 0, 0, time.UTC),
+			Description: "Deleted",
+			Cost:        "1.00",
+			DeletedAt:   &deleted,
+		},
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	err := WriteCSV(&buf, testExpenses(), CSVOptions{
+		Groups: []splitwise.Group{{ID: 10, Name: "House"}},
+	})
+	if err != nil {
+		t.Fatalf("write: %s", err)
+	}
+	expected := `Date,Description,Category,Cost,Currency,Group,Paid by,Ada Lovelace,Grace Hopper
+2021-03-01,Groceries,Food,30.00,USD,House,Ada Lovelace,15.00,15.00
+2021-03-02,Taxi,Transport,12.00,EUR,,Ada Lovelace (6.00); Grace Hopper (6.00),4.00,8.00
+`
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\n%s\nexpected:\n%s", buf.String(), expected)
+	}
+}
+
+func TestWriteCSVColumns(t *testing.T) {
+	var buf bytes.Buffer
+	err := WriteCSV(&buf, testExpenses(), CSVOptions{
+		Columns:        []Column{ColumnDescription, ColumnCost},
+		IncludeDeleted: true,
+	})
+	if err != nil {
+		t.Fatalf("write: %s", err)
+	}
+	expected := `Description,Cost
+Groceries,30.00
+Taxi,12.00
+Deleted,1.00
+`
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\n%s\nexpected:\n%s", buf.String(), expected)
+	}
+}
+
+func TestWriteCSVSplitwiseLayout(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, testExpenses(), CSVOptions{SplitwiseLayout: true}); err != nil {
+		t.Fatalf("write: %s", err)
+	}
+	expected := `Date,Description,Category,Cost,Currency,Ada Lovelace,Grace Hopper
+2021-03-01,Groceries,Food,30.00,USD,15.00,-15.00
+2021-03-02,Taxi,Transport,12.00,EUR,2.00,-2.00
+,Total balance,,,EUR,2.00,-2.00
+,Total balance,,,USD,15.00,-15.00
+`
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\n%s\nexpected:\n%s", buf.String(), expected)
+	}
+}
@@ -0,0 +1,79 @@
+// Package export writes splitwise expenses in formats understood by other tools.
+package export
+
+import (
+	"math/big"
+	"sort"
+	"strings"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+)
+
+// userName returns the display name of a user.
+func userName(u splitwise.User) string {
+	return strings.TrimSpace(u.FirstName + " " + u.LastName)
+}
+
+// participants returns every user involved in the given expenses, ordered by name.
+func participants(expenses []splitwise.Expense) []splitwise.User {
+	seen := make(map[int]splitwise.User)
+	for _, e := range expenses {
+		for _, eu := range e.Users {
+			u := eu.User
+			if u.ID == 0 {
+				u.ID = eu.UserID
+			}
+			seen[u.ID] = u
+		}
+	}
+	users := make([]splitwise.User, 0, len(seen))
+	for _, u := range seen {
+		users = append(users, u)
+	}
+	sort.Slice(users, func(i, j int) bool {
+		ni, nj := userName(users[i]), userName(users[j])
+		if ni != nj {
+			return ni < nj
+		}
+		return users[i].ID < users[j].ID
+	})
+	return users
+}
+
+// shareOf returns the share of the expense belonging to the user with the given ID.
+func shareOf(e splitwise.Expense, userID int) (splitwise.ExpenseUser, bool) {
+	for _, eu := range e.Users {
+		if eu.UserID == userID || eu.User.ID == userID {
+			return eu, true
+		}
+	}
+	return splitwise.ExpenseUser{}, false
+}
+
+// currencyDecimals holds the ISO 4217 minor units of currencies which do not have two.
+var currencyDecimals = map[string]int{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+	"PYG": 0, "RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0,
+	"XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"CLF": 4, "UYW": 4,
+}
+
+// formatAmount formats an amount with the number of decimals used by its currency,
+// or more if the amount cannot be represented exactly with them.
+func formatAmount(amount *big.Rat, currency string) string {
+	decimals, ok := currencyDecimals[currency]
+	if !ok {
+		decimals = 2
+	}
+	ten := big.NewRat(10, 1)
+	scaled := new(big.Rat).Set(amount)
+	for i := 0; i < decimals; i++ {
+		scaled.Mul(scaled, ten)
+	}
+	for !scaled.IsInt() && decimals < 8 {
+		scaled.Mul(scaled, ten)
+		decimals++
+	}
+	return amount.FloatString(decimals)
+}
@@ -0,0 +1,275 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strings"
+	"unicode"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+)
+
+// LedgerFormat is a plain-text accounting format.
+type LedgerFormat int
+
+const (
+	FormatLedger LedgerFormat = iota
+	FormatHledger
+	FormatBeancount
+)
+
+// LedgerOptions configures WriteLedger.
+type LedgerOptions struct {
+	Format LedgerFormat
+
+	// UserID is the user whose books are being written. Only expenses involving this
+	// user are included.
+	UserID int
+
+	// PaymentAccount is the account from which the user pays for expenses and into
+	// which they receive payments. It defaults to "Assets:Cash".
+	PaymentAccount string
+
+	// CategoryAccounts maps category names to expense accounts.
+	CategoryAccounts map[string]string
+	// ExpensePrefix is the parent of the expense account of categories missing from
+	// CategoryAccounts. It defaults to "Expenses:Splitwise".
+	ExpensePrefix string
+
+	// FriendAccounts maps user IDs to the account tracking the balance with that user.
+	FriendAccounts map[int]string
+	// FriendPrefix is the parent of the account of friends missing from
+	// FriendAccounts, e.g. "Liabilities:Splitwise:Alice". It defaults to
+	// "Liabilities:Splitwise".
+	FriendPrefix string
+}
+
+type posting struct {
+	account string
+	amount  *big.Rat
+}
+
+type transaction struct {
+	expense  splitwise.Expense
+	postings []posting
+}
+
+// WriteLedger writes expenses and payments as transactions in a plain-text accounting
+// format.
+//
+// Each transaction records the user's share of the expense against its category, the
+// amount they paid from PaymentAccount, and the resulting change in balance with each
+// friend. Amounts are kept in the currency of the expense, with as many decimals as it
+// uses. Transactions are ordered by date and carry the ID of the expense, so exporting
+// again produces a stable diff.
+func WriteLedger(w io.Writer, expenses []splitwise.Expense, opts LedgerOptions) error {
+	if opts.PaymentAccount == "" {
+		opts.PaymentAccount = "Assets:Cash"
+	}
+	if opts.ExpensePrefix == "" {
+		opts.ExpensePrefix = "Expenses:Splitwise"
+	}
+	if opts.FriendPrefix == "" {
+		opts.FriendPrefix = "Liabilities:Splitwise"
+	}
+
+	names := make(map[int]string)
+	for _, u := range participants(expenses) {
+		names[u.ID] = userName(u)
+	}
+	var txns []transaction
+	for _, e := range expenses {
+		if e.DeletedAt != nil {
+			continue
+		}
+		if t, ok := opts.transaction(e, names); ok {
+			txns = append(txns, t)
+		}
+	}
+	sort.SliceStable(txns, func(i, j int) bool {
+		di, dj := txns[i].expense.Date, txns[j].expense.Date
+		if !di.Equal(dj) {
+			return di.Before(dj)
+		}
+		return txns[i].expense.ID < txns[j].expense.ID
+	})
+
+	if opts.Format == FormatBeancount && len(txns) > 0 {
+		if err := writeOpenDirectives(w, txns); err != nil {
+			return err
+		}
+	}
+	for _, t := range txns {
+		if err := opts.write(w, t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (opts *LedgerOptions) transaction(e splitwise.Expense, names map[int]string) (transaction, bool) {
+	share, ok := shareOf(e, opts.UserID)
+	if !ok {
+		return transaction{}, false
+	}
+	paid, _ := parseAmount(share.PaidShare)
+	owed, _ := parseAmount(share.OwedShare)
+	t := transaction{expense: e}
+
+	if e.Payment {
+		// The user's owed share of a payment is the amount they received.
+		t.postings = append(t.postings, posting{opts.PaymentAccount, new(big.Rat).Sub(owed, paid)})
+	} else {
+		if owed.Sign() != 0 {
+			t.postings = append(t.postings, posting{opts.categoryAccount(e.Category.Name), owed})
+		}
+		if paid.Sign() != 0 {
+			t.postings = append(t.postings, posting{opts.PaymentAccount, new(big.Rat).Neg(paid)})
+		}
+	}
+
+	// A positive balance with a friend means they owe the user.
+	balances := make(map[int]*big.Rat)
+	var friends []int
+	add := func(friend int, amount *big.Rat) {
+		if balances[friend] == nil {
+			balances[friend] = new(big.Rat)
+			friends = append(friends, friend)
+		}
+		balances[friend].Add(balances[friend], amount)
+	}
+	var allocated big.Rat
+	for _, r := range e.Repayments {
+		amount, _ := parseAmount(r.Amount)
+		switch opts.UserID {
+		case r.To:
+			add(r.From, amount)
+			allocated.Add(&allocated, amount)
+		case r.From:
+			add(r.To, new(big.Rat).Neg(amount))
+			allocated.Sub(&allocated, amount)
+		}
+	}
+	sort.Ints(friends)
+	for _, friend := range friends {
+		if balances[friend].Sign() != 0 {
+			t.postings = append(t.postings, posting{opts.friendAccount(friend, names), balances[friend]})
+		}
+	}
+	// Without repayments the balance cannot be attributed to individual friends.
+	net := new(big.Rat).Sub(paid, owed)
+	if rest := net.Sub(net, &allocated); rest.Sign() != 0 {
+		t.postings = append(t.postings, posting{opts.FriendPrefix, rest})
+	}
+	return t, len(t.postings) > 0
+}
+
+func (opts *LedgerOptions) categoryAccount(category string) string {
+	if account, ok := opts.CategoryAccounts[category]; ok {
+		return account
+	}
+	if category == "" {
+		category = "General"
+	}
+	return opts.ExpensePrefix + ":" + accountComponent(category)
+}
+
+func (opts *LedgerOptions) friendAccount(id int, names map[int]string) string {
+	if account, ok := opts.FriendAccounts[id]; ok {
+		return account
+	}
+	name := accountComponent(names[id])
+	if name == "" {
+		name = fmt.Sprintf("User%d", id)
+	}
+	return opts.FriendPrefix + ":" + name
+}
+
+// accountComponent converts a name into a valid account name component for every
+// supported format, e.g. "dining out" becomes "DiningOut".
+func accountComponent(name string) string {
+	var sb strings.Builder
+	upper := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		sb.WriteRune(r)
+	}
+	s := sb.String()
+	if s != "" && !unicode.IsLetter([]rune(s)[0]) {
+		s = "X" + s
+	}
+	return s
+}
+
+func (opts *LedgerOptions) write(w io.Writer, t transaction) error {
+	e := t.expense
+	date := e.Date.Format("2006-01-02")
+	currency := e.CurrencyCode
+	if currency == "" {
+		currency = "USD"
+	}
+	var err error
+	switch opts.Format {
+	case FormatBeancount:
+		_, err = fmt.Fprintf(w, "%s * %q ^splitwise-%d\n  splitwise_id: \"%d\"\n", date, payee(e.Description, false), e.ID, e.ID)
+	case FormatHledger:
+		_, err = fmt.Fprintf(w, "%s (splitwise-%d) %s  ; splitwise_id:%d\n", date, e.ID, payee(e.Description, true), e.ID)
+	default:
+		_, err = fmt.Fprintf(w, "%s (splitwise-%d) %s\n    ; splitwise_id: %d\n", date, e.ID, payee(e.Description, true), e.ID)
+	}
+	if err != nil {
+		return err
+	}
+	for _, p := range t.postings {
+		if _, err := fmt.Fprintf(w, "    %-40s  %s %s\n", p.account, formatAmount(p.amount, currency), currency); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintln(w)
+	return err
+}
+
+// payee returns the description of an expense as the payee of a transaction, which
+// must fit on its header line without surrounding spaces. In ledger and hledger, where
+// the payee is not quoted, ';' starts a comment and is replaced with ','.
+func payee(description string, unquoted bool) string {
+	s := strings.Join(strings.Fields(description), " ")
+	if unquoted {
+		s = strings.ReplaceAll(s, ";", ",")
+	}
+	return s
+}
+
+// writeOpenDirectives opens every account used by the transactions, as required by
+// beancount.
+func writeOpenDirectives(w io.Writer, txns []transaction) error {
+	opened := make(map[string]string)
+	for _, t := range txns {
+		for _, p := range t.postings {
+			if _, ok := opened[p.account]; !ok {
+				opened[p.account] = t.expense.Date.Format("2006-01-02")
+			}
+		}
+	}
+	accounts := make([]string, 0, len(opened))
+	for account := range opened {
+		accounts = append(accounts, account)
+	}
+	sort.Strings(accounts)
+	for _, account := range accounts {
+		if _, err := fmt.Fprintf(w, "%s open %s\n", opened[account], account); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintln(w)
+	return err
+}
@@ -0,0 +1,166 @@
+package export
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+	"time"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+)
+
+func ledgerExpenses() []splitwise.Expense {
+	expenses := testExpenses()
+	expenses[0].Repayments = []splitwise.Repayment{{From: 2, To: 1, Amount: "15.00"}}
+	expenses[1].Repayments = []splitwise.Repayment{{From: 2, To: 1, Amount: "2.00"}}
+	return append(expenses, splitwise.Expense{
+		ID:           4,
+		Date:         time.Date(2021, 3, 5, 0, 0, 0, 0, time.UTC),
+		Description:  "Payment",
+		Payment:      true,
+		Cost:         "15.00",
+		CurrencyCode: "USD",
+		Users: []splitwise.ExpenseUser{
+			{UserID: 1, User: ada, PaidShare: "0.00", OwedShare: "15.00"},
+			{UserID: 2, User: grace, PaidShare: "15.00", OwedShare: "0.00"},
+		},
+		Repayments: []splitwise.Repayment{{From: 1, To: 2, Amount: "15.00"}},
+	})
+}
+
+func TestWriteLedger(t *testing.T) {
+	var buf bytes.Buffer
+	err := WriteLedger(&buf, ledgerExpenses(), LedgerOptions{
+		UserID:           1,
+		CategoryAccounts: map[string]string{"Food": "Expenses:Groceries"},
+	})
+	if err != nil {
+		t.Fatalf("write: %s", err)
+	}
+	expected := `2021-03-01 (splitwise-1) Groceries
+    ; splitwise_id: 1
+    Expenses:Groceries                        15.00 USD
+    Assets:Cash                               -30.00 USD
+    Liabilities:Splitwise:GraceHopper         15.00 USD
+
+2021-03-02 (splitwise-2) Taxi
+    ; splitwise_id: 2
+    Expenses:Splitwise:Transport              4.00 EUR
+    Assets:Cash                               -6.00 EUR
+    Liabilities:Splitwise:GraceHopper         2.00 EUR
+
+2021-03-05 (splitwise-4) Payment
+    ; splitwise_id: 4
+    Assets:Cash                               15.00 USD
+    Liabilities:Splitwise:GraceHopper         -15.00 USD
+
+`
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\n%s\nexpected:\n%s", buf.String(), expected)
+	}
+}
+
+func TestWriteBeancount(t *testing.T) {
+	var buf bytes.Buffer
+	err := WriteLedger(&buf, ledgerExpenses()[1:2], LedgerOptions{
+		Format:         FormatBeancount,
+		UserID:         2,
+		FriendAccounts: map[int]string{1: "Liabilities:Friends:Ada"},
+	})
+	if err != nil {
+		t.Fatalf("write: %s", err)
+	}
+	expected := `2021-03-02 open Assets:Cash
+2021-03-02 open Expenses:Splitwise:Transport
+2021-03-02 open Liabilities:Friends:Ada
+
+2021-03-02 * "Taxi" ^splitwise-2
+  splitwise_id: "2"
+    Expenses:Splitwise:Transport              8.00 EUR
+    Assets:Cash                               -6.00 EUR
+    Liabilities:Friends:Ada                   -2.00 EUR
+
+`
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\n%s\nexpected:\n%s", buf.String(), expected)
+	}
+}
+
+func TestAccountComponent(t *testing.T) {
+	cases := map[string]string{
+		"dining out":     "DiningOut",
+		"Gas/fuel":       "GasFuel",
+		"Ada Lovelace":   "AdaLovelace",
+		"24h store":      "X24hStore",
+		"Ünïcode names":  "ÜnïcodeNames",
+		"  leading rest": "LeadingRest",
+	}
+	for in, expected := range cases {
+		if actual := accountComponent(in); actual != expected {
+			t.Errorf("accountComponent(%q) = %q, expected %q", in, actual, expected)
+		}
+	}
+}
+
+func TestWriteLedgerCurrencyDecimalsAndPayee(t *testing.T) {
+	expenses := []splitwise.Expense{
+		{
+			ID:           5,
+			Date:         time.Date(2021, 3, 6, 0, 0, 0, 0, time.UTC),
+			Description:  "  Souq; spices\n",
+			Cost:         "1.250",
+			CurrencyCode: "KWD",
+			Users: []splitwise.ExpenseUser{
+				{UserID: 1, User: ada, PaidShare: "1.250", OwedShare: "1.250"},
+			},
+		},
+		{
+			ID:           6,
+			Date:         time.Date(2021, 3, 7, 0, 0, 0, 0, time.UTC),
+			Description:  "Ramen",
+			Cost:         "1200",
+			CurrencyCode: "JPY",
+			Users: []splitwise.ExpenseUser{
+				{UserID: 1, User: ada, PaidShare: "1200.0", OwedShare: "1200.0"},
+			},
+		},
+	}
+	var buf bytes.Buffer
+	if err := WriteLedger(&buf, expenses, LedgerOptions{UserID: 1}); err != nil {
+		t.Fatalf("write: %s", err)
+	}
+	expected := `2021-03-06 (splitwise-5) Souq, spices
+    ; splitwise_id: 5
+    Expenses:Splitwise:General                1.250 KWD
+    Assets:Cash                               -1.250 KWD
+
+2021-03-07 (splitwise-6) Ramen
+    ; splitwise_id: 6
+    Expenses:Splitwise:General                1200 JPY
+    Assets:Cash                               -1200 JPY
+
+`
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\n%s\nexpected:\n%s", buf.String(), expected)
+	}
+}
+
+func TestFormatAmount(t *testing.T) {
+	cases := []struct {
+		amount, currency, expected string
+	}{
+		{"12.5", "USD", "12.50"},
+		{"12.5", "", "12.50"},
+		{"1.25", "KWD", "1.250"},
+		{"1200", "JPY", "1200"},
+		// Amounts more precise than their currency are not rounded.
+		{"0.125", "USD", "0.125"},
+		{"10.5", "JPY", "10.5"},
+	}
+	for _, tc := range cases {
+		amount, _ := new(big.Rat).SetString(tc.amount)
+		if got := formatAmount(amount, tc.currency); got != tc.expected {
+			t.Errorf("formatAmount(%s, %q) = %s, expected %s", tc.amount, tc.currency, got, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+)
+
+// StatementOptions configures WriteOFX and WriteQIF.
+type StatementOptions struct {
+	// UserID is the user whose statement is written. Only expenses involving this
+	// user are included.
+	UserID int
+	// Currency restricts the statement to expenses in a single currency. QIF has no
+	// notion of currency, so it should be set when writing QIF for an account with
+	// expenses in several currencies.
+	Currency string
+	// DefaultCurrency is the currency of expenses without a currency code, usually the
+	// User.DefaultCurrency of the user. If empty, WriteOFX fails for such expenses.
+	DefaultCurrency string
+}
+
+// statementEntry is the effect of a single expense on the user.
+type statementEntry struct {
+	expense splitwise.Expense
+	amount  *big.Rat
+}
+
+// fitID is the stable identifier of the transaction for an expense.
+func fitID(e splitwise.Expense) string {
+	return fmt.Sprintf("splitwise-%d", e.ID)
+}
+
+// statementEntries returns the net effect of each expense on the user: the negated
+// share they owe for an expense, or the amount they received (or paid) for a payment.
+func statementEntries(expenses []splitwise.Expense, opts StatementOptions) []statementEntry {
+	var entries []statementEntry
+	for _, e := range expenses {
+		if e.DeletedAt != nil {
+			continue
+		}
+		if opts.Currency != "" && e.CurrencyCode != opts.Currency {
+			continue
+		}
+		share, ok := shareOf(e, opts.UserID)
+		if !ok {
+			continue
+		}
+		paid, _ := parseAmount(share.PaidShare)
+		owed, _ := parseAmount(share.OwedShare)
+		var amount *big.Rat
+		if e.Payment {
+			amount = new(big.Rat).Sub(owed, paid)
+		} else {
+			amount = new(big.Rat).Neg(owed)
+		}
+		if amount.Sign() == 0 {
+			continue
+		}
+		entries = append(entries, statementEntry{expense: e, amount: amount})
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		di, dj := entries[i].expense.Date, entries[j].expense.Date
+		if !di.Equal(dj) {
+			return di.Before(dj)
+		}
+		return entries[i].expense.ID < entries[j].expense.ID
+	})
+	return entries
+}
+
+// WriteQIF writes the user's net effect of each expense as a QIF bank account.
+//
+// The ID of each expense is recorded in the memo of its transaction.
+func WriteQIF(w io.Writer, expenses []splitwise.Expense, opts StatementOptions) error {
+	if _, err := fmt.Fprintln(w, "!Type:Bank"); err != nil {
+		return err
+	}
+	for _, entry := range statementEntries(expenses, opts) {
+		e := entry.expense
+		category := e.Category.Name
+		if e.Payment {
+			category = "Payment"
+		}
+		_, err := fmt.Fprintf(w, "D%s\nT%s\nP%s\nL%s\nM%s\n^\n",
+			e.Date.Format("01/02/2006"),
+			entry.amount.FloatString(2),
+			qifText(e.Description),
+			qifText(category),
+			fitID(e),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func qifText(s string) string {
+	return strings.NewReplacer("\n", " ", "\r", " ").Replace(s)
+}
+
+// WriteOFX writes the user's net effect of each expense as an OFX 1.0.2 bank statement,
+// encoded as UTF-8.
+//
+// Expenses in different currencies are written to separate statements. The FITID of
+// each transaction is derived from the ID of its expense, so importing an updated
+// export does not duplicate transactions.
+//
+// An error is returned, without writing anything, if an expense has no currency code
+// and opts.DefaultCurrency is empty.
+func WriteOFX(w io.Writer, expenses []splitwise.Expense, opts StatementOptions) error {
+	entries := statementEntries(expenses, opts)
+	byCurrency := make(map[string][]statementEntry)
+	var currencies []string
+	var latest time.Time
+	for _, entry := range entries {
+		cur := entry.expense.CurrencyCode
+		if cur == "" {
+			cur = opts.DefaultCurrency
+		}
+		if cur == "" {
+			return fmt.Errorf("expense %d has no currency code and no default currency is set", entry.expense.ID)
+		}
+		if _, ok := byCurrency[cur]; !ok {
+			currencies = append(currencies, cur)
+		}
+		byCurrency[cur] = append(byCurrency[cur], entry)
+		if entry.expense.Date.After(latest) {
+			latest = entry.expense.Date
+		}
+	}
+	sort.Strings(currencies)
+
+	ow := &ofxWriter{w: w}
+	ow.printf("OFXHEADER:100\nDATA:OFXSGML\nVERSION:102\nSECURITY:NONE\nENCODING:UTF-8\n")
+	ow.printf("CHARSET:NONE\nCOMPRESSION:NONE\nOLDFILEUID:NONE\nNEWFILEUID:NONE\n\n")
+	ow.printf("<OFX>\n<SIGNONMSGSRSV1>\n<SONRS>\n")
+	ow.printf("<STATUS><CODE>0<SEVERITY>INFO</STATUS>\n")
+	// The server time is the latest expense, rather than the current time, so that
+	// exporting the same expenses twice produces the same file.
+	ow.printf("<DTSERVER>%s\n<LANGUAGE>ENG\n", ofxTime(latest))
+	ow.printf("</SONRS>\n</SIGNONMSGSRSV1>\n<BANKMSGSRSV1>\n")
+	for i, cur := range currencies {
+		entries := byCurrency[cur]
+		balance := new(big.Rat)
+		ow.printf("<STMTTRNRS>\n<TRNUID>%d\n<STATUS><CODE>0<SEVERITY>INFO</STATUS>\n", i+1)
+		ow.printf("<STMTRS>\n<CURDEF>%s\n", ofxText(cur))
+		ow.printf("<BANKACCTFROM><BANKID>SPLITWISE<ACCTID>splitwise-%d-%s<ACCTTYPE>CHECKING</BANKACCTFROM>\n", opts.UserID, ofxText(cur))
+		ow.printf("<BANKTRANLIST>\n<DTSTART>%s\n<DTEND>%s\n", ofxTime(entries[0].expense.Date), ofxTime(entries[len(entries)-1].expense.Date))
+		for _, entry := range entries {
+			e := entry.expense
+			balance.Add(balance, entry.amount)
+			trnType := "DEBIT"
+			if entry.amount.Sign() > 0 {
+				trnType = "CREDIT"
+			}
+			ow.printf("<STMTTRN>\n<TRNTYPE>%s\n<DTPOSTED>%s\n<TRNAMT>%s\n<FITID>%s\n<NAME>%s\n",
+				trnType, ofxTime(e.Date), entry.amount.FloatString(2), fitID(e), ofxText(truncate(e.Description, 32)))
+			if e.Category.Name != "" {
+				ow.printf("<MEMO>%s\n", ofxText(e.Category.Name))
+			}
+			ow.printf("</STMTTRN>\n")
+		}
+		ow.printf("</BANKTRANLIST>\n")
+		ow.printf("<LEDGERBAL><BALAMT>%s<DTASOF>%s</LEDGERBAL>\n", balance.FloatString(2), ofxTime(latest))
+		ow.printf("</STMTRS>\n</STMTTRNRS>\n")
+	}
+	ow.printf("</BANKMSGSRSV1>\n</OFX>\n")
+	return ow.err
+}
+
+// ofxWriter remembers the first error encountered while writing.
+type ofxWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (ow *ofxWriter) printf(format string, args ...interface{}) {
+	if ow.err != nil {
+		return
+	}
+	_, ow.err = fmt.Fprintf(ow.w, format, args...)
+}
+
+func ofxTime(t time.Time) string {
+	return t.UTC().Format("20060102150405")
+}
+
+func ofxText(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\n", " ").Replace(s)
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n])
+}
@@ -0,0 +1,88 @@
+package export
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+)
+
+func TestWriteQIF(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteQIF(&buf, ledgerExpenses(), StatementOptions{UserID: 1, Currency: "USD"}); err != nil {
+		t.Fatalf("write: %s", err)
+	}
+	expected := `!Type:Bank
+D03/01/2021
+T-15.00
+PGroceries
+LFood
+Msplitwise-1
+^
+D03/05/2021
+T15.00
+PPayment
+LPayment
+Msplitwise-4
+^
+`
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\n%s\nexpected:\n%s", buf.String(), expected)
+	}
+}
+
+func TestWriteOFX(t *testing.T) {
+	var first, second bytes.Buffer
+	if err := WriteOFX(&first, ledgerExpenses(), StatementOptions{UserID: 2}); err != nil {
+		t.Fatalf("write: %s", err)
+	}
+	if err := WriteOFX(&second, ledgerExpenses(), StatementOptions{UserID: 2}); err != nil {
+		t.Fatalf("write: %s", err)
+	}
+	if first.String() != second.String() {
+		t.Errorf("expected repeated exports to be identical")
+	}
+	out := first.String()
+	for _, expected := range []string{
+		"ENCODING:UTF-8\nCHARSET:NONE\n",
+		"<CURDEF>EUR\n",
+		"<CURDEF>USD\n",
+		"<TRNTYPE>DEBIT\n<DTPOSTED>20210301180000\n<TRNAMT>-15.00\n<FITID>splitwise-1\n<NAME>Groceries\n<MEMO>Food\n",
+		"<TRNAMT>-8.00\n<FITID>splitwise-2\n",
+		"<TRNAMT>-15.00\n<FITID>splitwise-4\n",
+		"<LEDGERBAL><BALAMT>-30.00",
+		"<LEDGERBAL><BALAMT>-8.00",
+	} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("expected output to contain %q:\n%s", expected, out)
+		}
+	}
+	if strings.Index(out, "<CURDEF>EUR") > strings.Index(out, "<CURDEF>USD") {
+		t.Errorf("expected statements to be ordered by currency")
+	}
+}
+
+func TestWriteOFXDefaultCurrency(t *testing.T) {
+	expenses := []splitwise.Expense{{
+		ID:          1,
+		Description: "Café",
+		Cost:        "10.00",
+		Users:       []splitwise.ExpenseUser{{UserID: 2, OwedShare: "10.00", PaidShare: "0.00"}},
+	}}
+	var buf bytes.Buffer
+	if err := WriteOFX(&buf, expenses, StatementOptions{UserID: 2}); err == nil {
+		t.Errorf("expected an error for an expense without a currency")
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected nothing to be written, got:\n%s", buf.String())
+	}
+	if err := WriteOFX(&buf, expenses, StatementOptions{UserID: 2, DefaultCurrency: "GBP"}); err != nil {
+		t.Fatalf("write: %s", err)
+	}
+	for _, expected := range []string{"<CURDEF>GBP\n", "<NAME>Café\n"} {
+		if !strings.Contains(buf.String(), expected) {
+			t.Errorf("expected output to contain %q:\n%s", expected, buf.String())
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package splitwise
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+)
+
+// extraFields is embedded in models to retain the fields of the JSON they were decoded
+// from that have no corresponding field in the model, so that fields added to the API
+// can be read before the models are updated.
+//
+// Models embedding it decode with extraFields.decode and encode with
+// extraFields.marshal, so that unknown fields survive a round trip through JSON.
+//
+// The unknown fields are held by pointer so that models remain comparable. Two models
+// only compare equal with == if neither has unknown fields, or they share them.
+type extraFields struct {
+	unknown *unknownFields
+}
+
+type unknownFields struct {
+	fields map[string]json.RawMessage
+}
+
+// UnknownFields returns the fields of the JSON object the value was decoded from that
+// have no corresponding field in its type, by name.
+func (x *extraFields) UnknownFields() map[string]json.RawMessage {
+	if x.unknown == nil {
+		return nil
+	}
+	fields := make(map[string]json.RawMessage, len(x.unknown.fields))
+	for name, value := range x.unknown.fields {
+		fields[name] = value
+	}
+	return fields
+}
+
+// DecodeField decodes the unknown field with the given name into v, e.g. to read a
+// field not yet supported by this package.
+//
+// It reports whether the field was present.
+func (x *extraFields) DecodeField(name string, v interface{}) (bool, error) {
+	if x.unknown == nil {
+		return false, nil
+	}
+	value, ok := x.unknown.fields[name]
+	if !ok {
+		return false, nil
+	}
+	return true, json.Unmarshal(value, v)
+}
+
+// extra allows strict decoding to check the fields of models despite their
+// UnmarshalJSON methods.
+func (x *extraFields) extra() *extraFields {
+	return x
+}
+
+type extraFielder interface {
+	extra() *extraFields
+}
+
+// decode unmarshals data into v, a pointer to the model embedding x without its
+// UnmarshalJSON method, and captures its unknown fields.
+func (x *extraFields) decode(data []byte, v interface{}) error {
+	err := json.Unmarshal(data, v)
+	var typeErr *json.UnmarshalTypeError
+	if err != nil && !errors.As(err, &typeErr) {
+		return err
+	}
+	// The rest of the object is decoded despite a type error, so capture it anyway.
+	if captureErr := x.capture(data, reflect.TypeOf(v).Elem()); captureErr != nil {
+		return captureErr
+	}
+	return err
+}
+
+// capture records the fields of data, the JSON object decoded into a value of type t,
+// that t does not have.
+func (x *extraFields) capture(data []byte, t reflect.Type) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	x.unknown = nil
+	for name, value := range fields {
+		if _, ok := fieldByJSONName(t, name); ok {
+			continue
+		}
+		if x.unknown == nil {
+			x.unknown = &unknownFields{fields: make(map[string]json.RawMessage)}
+		}
+		x.unknown.fields[name] = value
+	}
+	return nil
+}
+
+// marshal encodes v, a value of a model type without its MarshalJSON method, along
+// with the unknown fields it was decoded with.
+func (x *extraFields) marshal(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || x.unknown == nil {
+		return data, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for name, value := range x.unknown.fields {
+		if _, ok := fields[name]; !ok {
+			fields[name] = value
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// rawJSON returns the encoding of a model for its RawJSON method.
+func rawJSON(v json.Marshaler) json.RawMessage {
+	data, err := v.MarshalJSON()
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+func (e *Expense) UnmarshalJSON(data []byte) error {
+	type expense Expense
+	return e.decode(data, (*expense)(e))
+}
+
+func (e Expense) MarshalJSON() ([]byte, error) {
+	type expense Expense
+	return e.marshal(expense(e))
+}
+
+// RawJSON returns the JSON encoding of e, including the unknown fields it was decoded
+// with. It is derived on each call rather than kept from the response, so it may differ
+// from the response in formatting and field order.
+func (e Expense) RawJSON() json.RawMessage {
+	return rawJSON(e)
+}
+
+func (g *Group) UnmarshalJSON(data []byte) error {
+	type group Group
+	return g.decode(data, (*group)(g))
+}
+
+func (g Group) MarshalJSON() ([]byte, error) {
+	type group Group
+	return g.marshal(group(g))
+}
+
+// RawJSON returns the JSON encoding of g, including the unknown fields it was decoded
+// with. It is derived on each call rather than kept from the response, so it may differ
+// from the response in formatting and field order.
+func (g Group) RawJSON() json.RawMessage {
+	return rawJSON(g)
+}
+
+func (u *User) UnmarshalJSON(data []byte) error {
+	type user User
+	return u.decode(data, (*user)(u))
+}
+
+func (u User) MarshalJSON() ([]byte, error) {
+	type user User
+	return u.marshal(user(u))
+}
+
+// RawJSON returns the JSON encoding of u, including the unknown fields it was decoded
+// with. It is derived on each call rather than kept from the response, so it may differ
+// from the response in formatting and field order.
+func (u User) RawJSON() json.RawMessage {
+	return rawJSON(u)
+}
+
+func (f *Friend) UnmarshalJSON(data []byte) error {
+	type friend Friend
+	return f.decode(data, (*friend)(f))
+}
+
+func (f Friend) MarshalJSON() ([]byte, error) {
+	type friend Friend
+	return f.marshal(friend(f))
+}
+
+// RawJSON returns the JSON encoding of f, including the unknown fields it was decoded
+// with. It is derived on each call rather than kept from the response, so it may differ
+// from the response in formatting and field order.
+func (f Friend) RawJSON() json.RawMessage {
+	return rawJSON(f)
+}
+
+func (c *Comment) UnmarshalJSON(data []byte) error {
+	type comment Comment
+	return c.decode(data, (*comment)(c))
+}
+
+func (c Comment) MarshalJSON() ([]byte, error) {
+	type comment Comment
+	return c.marshal(comment(c))
+}
+
+// RawJSON returns the JSON encoding of c, including the unknown fields it was decoded
+// with. It is derived on each call rather than kept from the response, so it may differ
+// from the response in formatting and field order.
+func (c Comment) RawJSON() json.RawMessage {
+	return rawJSON(c)
+}
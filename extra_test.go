@@ -0,0 +1,126 @@
+package splitwise
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestUnknownFields(t *testing.T) {
+	data := `{
+		"id": 1,
+		"description": "Dinner",
+		"repeats": true,
+		"receipt": {"large": "https://example.com/receipt.png"},
+		"users": [{"user_id": 2, "user": {"id": 2, "first_name": "Grace", "pronouns": "she/her"}}]
+	}`
+	var e Expense
+	if err := json.Unmarshal([]byte(data), &e); err != nil {
+		t.Fatal(err)
+	}
+	if e.ID != 1 || e.Description != "Dinner" {
+		t.Errorf("known fields not decoded: %+v", e)
+	}
+	var raw map[string]interface{}
+	if err := json.Unmarshal(e.RawJSON(), &raw); err != nil {
+		t.Fatal(err)
+	}
+	if raw["description"] != "Dinner" || raw["repeats"] != true {
+		t.Errorf("unexpected raw JSON %s", e.RawJSON())
+	}
+	unknown := e.UnknownFields()
+	if len(unknown) != 2 || string(unknown["repeats"]) != "true" {
+		t.Errorf("unexpected unknown fields %v", unknown)
+	}
+
+	var receipt struct {
+		Large string `json:"large"`
+	}
+	if ok, err := e.DecodeField("receipt", &receipt); !ok || err != nil || receipt.Large != "https://example.com/receipt.png" {
+		t.Errorf("DecodeField(receipt) = %v, %v, %+v", ok, err, receipt)
+	}
+	var description string
+	if ok, err := e.DecodeField("description", &description); ok || err != nil {
+		t.Errorf("expected only unknown fields to be decodable, got %v, %v, %q", ok, err, description)
+	}
+	if ok, err := e.DecodeField("missing", &description); ok || err != nil {
+		t.Errorf("DecodeField(missing) = %v, %v", ok, err)
+	}
+
+	user := e.Users[0].User
+	var pronouns string
+	if ok, _ := user.DecodeField("pronouns", &pronouns); !ok || pronouns != "she/her" {
+		t.Errorf("expected the unknown fields of nested models, got %v", user.UnknownFields())
+	}
+}
+
+func TestUnknownFieldsRoundTrip(t *testing.T) {
+	data := `{"id": 10, "name": "Flat", "whiteboard": "Rent is due on the 1st", "members": [{"id": 1}]}`
+	var g Group
+	if err := json.Unmarshal([]byte(data), &g); err != nil {
+		t.Fatal(err)
+	}
+	g.Name = "House"
+	encoded, err := json.Marshal(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Group
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	var whiteboard string
+	if ok, _ := decoded.DecodeField("whiteboard", &whiteboard); !ok || whiteboard != "Rent is due on the 1st" {
+		t.Errorf("unknown field lost in round trip: %s", encoded)
+	}
+	if decoded.Name != "House" || decoded.ID != 10 || len(decoded.Members) != 1 {
+		t.Errorf("known fields lost in round trip: %s", encoded)
+	}
+}
+
+func TestUnknownFieldsNotDecoded(t *testing.T) {
+	c := Comment{ID: 1, Content: "hello"}
+	if c.UnknownFields() != nil {
+		t.Error("expected no unknown fields for a model not decoded from JSON")
+	}
+	if ok, err := c.DecodeField("content", new(string)); ok || err != nil {
+		t.Errorf("DecodeField = %v, %v", ok, err)
+	}
+	encoded, err := json.Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		t.Fatal(err)
+	}
+	if fields["content"] != "hello" || len(fields) != 8 {
+		t.Errorf("unexpected encoding %s", encoded)
+	}
+	if string(c.RawJSON()) != string(encoded) {
+		t.Errorf("expected RawJSON to match the encoding, got %s", c.RawJSON())
+	}
+}
+
+func TestModelsComparable(t *testing.T) {
+	var a, b User
+	json.Unmarshal([]byte(`{"id": 1, "first_name": "Ada"}`), &a)
+	json.Unmarshal([]byte(`{"id": 1, "first_name": "Ada"}`), &b)
+	if a != b {
+		t.Errorf("expected users without unknown fields to compare equal")
+	}
+	json.Unmarshal([]byte(`{"id": 1, "first_name": "Ada", "pronouns": "she/her"}`), &b)
+	if a == b {
+		t.Errorf("expected a user with unknown fields to differ")
+	}
+}
+
+func TestUnknownFieldsTypeMismatch(t *testing.T) {
+	var f Friend
+	err := json.Unmarshal([]byte(`{"id": "1", "first_name": "Ada", "nickname": "A"}`), &f)
+	if err == nil {
+		t.Fatal("expected a type error")
+	}
+	if f.FirstName != "Ada" || f.UnknownFields()["nickname"] == nil {
+		t.Errorf("expected the rest of the object to be decoded, got %+v", f)
+	}
+}
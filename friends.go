@@ -9,6 +9,8 @@ import (
 )
 
 type Friend struct {
+	extraFields
+
 	ID        int              `json:"id"`
 	FirstName string           `json:"first_name"`
 	LastName  string           `json:"last_name"`
@@ -29,9 +31,9 @@ type Balance struct {
 }
 
 type CreateFriendRequest struct {
-	FirstName string
-	LastName  string
-	Email     string
+	FirstName string `json:"user_first_name"`
+	LastName  string `json:"user_last_name"`
+	Email     string `json:"user_email"`
 }
 
 func (c *Client) GetFriends(ctx context.Context) ([]Friend, error) {
@@ -52,8 +54,8 @@ func (c *Client) GetFriend(ctx context.Context, id int) (*Friend, error) {
 
 func (c *Client) DeleteFriend(ctx context.Context, id int) error {
 	var res struct {
-		Success bool     `json:"success"`
-		Errors  APIError `json:"errors"`
+		Success bool `json:"success"`
+		errorsResponse
 	}
 	if err := c.do(
 		ctx,
@@ -73,10 +75,9 @@ func (c *Client) DeleteFriend(ctx context.Context, id int) error {
 }
 
 func (c *Client) CreateFriend(ctx context.Context, req *CreateFriendRequest) (*Friend, error) {
-	values := map[string][]string{
-		"user_email":      {req.Email},
-		"user_first_name": {req.FirstName},
-		"user_last_name":  {req.LastName},
+	rw := newRequest()
+	if err := encodeRequest(rw, req); err != nil {
+		return nil, err
 	}
 	var res struct {
 		Friend Friend `json:"friend"`
@@ -85,7 +86,7 @@ func (c *Client) CreateFriend(ctx context.Context, req *CreateFriendRequest) (*F
 		ctx,
 		http.MethodPost,
 		&url.URL{Path: "create_friend"},
-		values,
+		&rw,
 		&res,
 	)
 	return &res.Friend, err
@@ -93,21 +94,20 @@ func (c *Client) CreateFriend(ctx context.Context, req *CreateFriendRequest) (*F
 
 func (c *Client) CreateFriends(ctx context.Context, req ...*CreateFriendRequest) ([]Friend, error) {
 	rw := newRequest()
-	arr := rw.Array("friends")
-	for _, user := range req {
-		arr.Str("user_first_name", user.FirstName)
-		arr.Str("user_last_name", user.LastName)
-		arr.Str("user_email", user.Email)
-		arr.Next()
+	err := encodeRequest(rw, struct {
+		Friends []*CreateFriendRequest `json:"friends"`
+	}{req})
+	if err != nil {
+		return nil, err
 	}
 	var res struct {
 		Friends []Friend `json:"friends"`
 	}
-	err := c.do(
+	err = c.do(
 		ctx,
 		http.MethodPost,
 		&url.URL{Path: "create_friend"},
-		rw.Values,
+		&rw,
 		&res,
 	)
 	return res.Friends, err
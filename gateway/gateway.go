@@ -0,0 +1,238 @@
+// Package gateway serves a JSON REST API backed by a splitwise.Client, so that services
+// written in other languages can use splitwise without holding its credentials.
+//
+// Callers authenticate with keys issued by the gateway, sent as a bearer token:
+//
+//	client := splitwise.NewClient(oauthClient)
+//	server := gateway.New(client, gateway.StaticKeys(map[string]string{
+//		os.Getenv("BILLING_KEY"): "billing",
+//	}))
+//	http.ListenAndServe(":8080", server)
+//
+// The routes are:
+//
+//	GET    /me
+//	GET    /users/{id}
+//	GET    /categories
+//	GET    /currencies
+//	GET    /groups
+//	POST   /groups
+//	GET    /groups/{id}
+//	DELETE /groups/{id}
+//	POST   /groups/{id}/restore
+//	POST   /groups/{id}/members
+//	DELETE /groups/{id}/members/{user_id}
+//	GET    /friends
+//	POST   /friends
+//	GET    /friends/{id}
+//	DELETE /friends/{id}
+//	GET    /expenses
+//	POST   /expenses
+//	GET    /expenses/{id}
+//	DELETE /expenses/{id}
+//	POST   /expenses/{id}/restore
+//	GET    /expenses/{id}/comments
+//	POST   /expenses/{id}/comments
+//	DELETE /comments/{id}
+//
+// Request and response bodies are JSON. Errors are returned as application/problem+json
+// documents, described by Problem.
+package gateway
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+)
+
+// Authenticator returns the name of the caller holding key, or false if the key is not
+// valid.
+type Authenticator func(key string) (caller string, ok bool)
+
+// StaticKeys returns an Authenticator for a fixed set of keys, mapping each key to the
+// name of its caller.
+func StaticKeys(keys map[string]string) Authenticator {
+	type entry struct {
+		hash   [sha256.Size]byte
+		caller string
+	}
+	entries := make([]entry, 0, len(keys))
+	for key, caller := range keys {
+		entries = append(entries, entry{sha256.Sum256([]byte(key)), caller})
+	}
+	return func(key string) (string, bool) {
+		// Compare digests of every key in constant time, so that neither the keys
+		// nor which of them matched can be learnt from timing.
+		hash := sha256.Sum256([]byte(key))
+		var caller string
+		found := 0
+		for _, e := range entries {
+			if subtle.ConstantTimeCompare(hash[:], e.hash[:]) == 1 {
+				caller = e.caller
+				found = 1
+			}
+		}
+		return caller, found == 1 && key != ""
+	}
+}
+
+type callerKey struct{}
+
+// Caller returns the name of the authenticated caller of a request handled by the
+// gateway.
+func Caller(ctx context.Context) string {
+	caller, _ := ctx.Value(callerKey{}).(string)
+	return caller
+}
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithErrorLog logs requests which fail due to an upstream error to logger.
+func WithErrorLog(logger *log.Logger) Option {
+	return func(s *Server) {
+		s.errorLog = logger
+	}
+}
+
+// WithMaxBodySize limits the size of request bodies. The default is 1MiB.
+func WithMaxBodySize(n int64) Option {
+	return func(s *Server) {
+		s.maxBodySize = n
+	}
+}
+
+// Server is an http.Handler serving the gateway API.
+type Server struct {
+	client      *splitwise.Client
+	auth        Authenticator
+	errorLog    *log.Logger
+	maxBodySize int64
+	routes      []route
+}
+
+// New returns a Server which performs requests with client, for callers accepted by auth.
+func New(client *splitwise.Client, auth Authenticator, opts ...Option) *Server {
+	s := &Server{
+		client:      client,
+		auth:        auth,
+		maxBodySize: 1 << 20,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.routes = s.buildRoutes()
+	return s
+}
+
+// handlerFunc handles a request, given the integer parameters of its path, returning
+// the status and body of the response.
+type handlerFunc func(r *http.Request, params []int) (int, interface{}, error)
+
+type route struct {
+	method  string
+	pattern []string
+	handle  handlerFunc
+}
+
+func (s *Server) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	var caller string
+	ok := false
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+		caller, ok = s.auth(strings.TrimPrefix(auth, "Bearer "))
+	}
+	if !ok {
+		rw.Header().Set("WWW-Authenticate", `Bearer realm="splitwise-gateway"`)
+		writeProblem(rw, &Problem{
+			Type:   TypeUnauthorized,
+			Title:  "Unauthorized",
+			Status: http.StatusUnauthorized,
+			Detail: "a valid API key must be sent as a bearer token",
+		})
+		return
+	}
+	r = r.WithContext(context.WithValue(r.Context(), callerKey{}, caller))
+	if r.Body != nil {
+		r.Body = http.MaxBytesReader(rw, r.Body, s.maxBodySize)
+	}
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	var allowed []string
+	for _, rt := range s.routes {
+		params, ok := rt.match(segments)
+		if !ok {
+			continue
+		}
+		if rt.method != r.Method {
+			allowed = append(allowed, rt.method)
+			continue
+		}
+		status, body, err := rt.handle(r, params)
+		if err != nil {
+			p := problemFor(err)
+			if p.Status >= 500 && s.errorLog != nil {
+				s.errorLog.Printf("%s %s (caller %s): %s", r.Method, r.URL.Path, caller, err)
+			}
+			writeProblem(rw, p)
+			return
+		}
+		writeJSON(rw, status, body)
+		return
+	}
+	if len(allowed) > 0 {
+		rw.Header().Set("Allow", strings.Join(allowed, ", "))
+		writeProblem(rw, &Problem{Type: TypeMethodNotAllowed, Title: "Method not allowed", Status: http.StatusMethodNotAllowed})
+		return
+	}
+	writeProblem(rw, &Problem{Type: TypeNotFound, Title: "Not found", Status: http.StatusNotFound})
+}
+
+// match reports whether segments match the pattern of the route, returning the values
+// of any "{...}" parameters.
+func (rt route) match(segments []string) ([]int, bool) {
+	if len(segments) != len(rt.pattern) {
+		return nil, false
+	}
+	var params []int
+	for i, p := range rt.pattern {
+		if !strings.HasPrefix(p, "{") {
+			if segments[i] != p {
+				return nil, false
+			}
+			continue
+		}
+		n, err := strconv.Atoi(segments[i])
+		if err != nil {
+			return nil, false
+		}
+		params = append(params, n)
+	}
+	return params, true
+}
+
+func writeJSON(rw http.ResponseWriter, status int, body interface{}) {
+	if status == http.StatusNoContent {
+		rw.WriteHeader(status)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(status)
+	json.NewEncoder(rw).Encode(body)
+}
+
+// decode reads the JSON body of r into v.
+func decode(r *http.Request, v interface{}) error {
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return invalid("invalid JSON body: " + err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,259 @@
+package gateway
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+)
+
+const testKey = "k3y"
+
+// newTestServer returns a gateway backed by a fake splitwise API served by upstream.
+// The form values of the last request to upstream are stored in sent.
+func newTestServer(t *testing.T, upstream http.HandlerFunc) (*Server, *url.Values) {
+	sent := &url.Values{}
+	api := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		*sent = r.PostForm
+		upstream(rw, r)
+	}))
+	t.Cleanup(api.Close)
+	u, _ := url.Parse(api.URL + "/api/v3.0/")
+	client := splitwise.NewClient(api.Client(), splitwise.WithBaseURL(u))
+	return New(client, StaticKeys(map[string]string{testKey: "tests"})), sent
+}
+
+func serve(s *Server, method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+testKey)
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+	return rec
+}
+
+func decodeProblem(t *testing.T, rec *httptest.ResponseRecorder) Problem {
+	t.Helper()
+	if ct := rec.Header().Get("Content-Type"); ct != "application/problem+json" {
+		t.Fatalf("expected a problem, got %s: %s", ct, rec.Body.String())
+	}
+	var p Problem
+	if err := json.Unmarshal(rec.Body.Bytes(), &p); err != nil {
+		t.Fatal(err)
+	}
+	if p.Status != rec.Code {
+		t.Errorf("problem status %d does not match response status %d", p.Status, rec.Code)
+	}
+	return p
+}
+
+func TestAuthentication(t *testing.T) {
+	s, _ := newTestServer(t, func(rw http.ResponseWriter, r *http.Request) {
+		rw.Write([]byte(`{"user": {"id": 1}}`))
+	})
+	for _, header := range []string{"", "Bearer", "Bearer wrong", testKey} {
+		req := httptest.NewRequest(http.MethodGet, "/me", nil)
+		req.Header.Set("Authorization", header)
+		rec := httptest.NewRecorder()
+		s.ServeHTTP(rec, req)
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("expected %q to be rejected, got %d", header, rec.Code)
+			continue
+		}
+		if p := decodeProblem(t, rec); p.Type != TypeUnauthorized {
+			t.Errorf("unexpected problem: %+v", p)
+		}
+	}
+	if rec := serve(s, http.MethodGet, "/me", ""); rec.Code != http.StatusOK {
+		t.Errorf("expected a valid key to be accepted, got %d", rec.Code)
+	}
+}
+
+func TestCreateExpense(t *testing.T) {
+	s, sent := newTestServer(t, func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v3.0/create_expense" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		rw.Write([]byte(`{"expense": {"id": 42, "description": "Pizza", "cost": "10.00"}}`))
+	})
+	rec := serve(s, http.MethodPost, "/expenses", `{
+		"cost": "10.00",
+		"description": "Pizza",
+		"group_id": 7,
+		"users": [
+			{"user_id": 1, "paid_share": "10.00", "owed_share": "4.00"},
+			{"email": "grace@example.com", "first_name": "Grace", "paid_share": "0.00", "owed_share": "6.00"}
+		]
+	}`)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("unexpected status %d: %s", rec.Code, rec.Body.String())
+	}
+	var expense splitwise.Expense
+	if err := json.Unmarshal(rec.Body.Bytes(), &expense); err != nil || expense.ID != 42 {
+		t.Errorf("unexpected response %s (%v)", rec.Body.String(), err)
+	}
+	expected := map[string]string{
+		"group_id":             "7",
+		"users__0__user_id":    "1",
+		"users__0__owed_share": "4.00",
+		"users__1__email":      "grace@example.com",
+		"users__1__owed_share": "6.00",
+	}
+	for k, v := range expected {
+		if got := sent.Get(k); got != v {
+			t.Errorf("expected %s=%q, got %q", k, v, got)
+		}
+	}
+}
+
+func TestProblems(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		body     string
+		upstream http.HandlerFunc
+		status   int
+		typ      string
+	}{
+		{
+			name:   "api error",
+			method: http.MethodPost,
+			path:   "/expenses/5/comments",
+			body:   `{"content": "hi"}`,
+			upstream: func(rw http.ResponseWriter, r *http.Request) {
+				rw.Write([]byte(`{"errors": {"base": ["You cannot comment on this expense"]}}`))
+			},
+			status: http.StatusUnprocessableEntity,
+			typ:    TypeAPIError,
+		},
+		{
+			name:   "not found upstream",
+			method: http.MethodGet,
+			path:   "/expenses/5",
+			upstream: func(rw http.ResponseWriter, r *http.Request) {
+				rw.WriteHeader(http.StatusNotFound)
+			},
+			status: http.StatusNotFound,
+			typ:    TypeNotFound,
+		},
+		{
+			name:   "upstream failure",
+			method: http.MethodGet,
+			path:   "/groups",
+			upstream: func(rw http.ResponseWriter, r *http.Request) {
+				rw.WriteHeader(http.StatusUnauthorized)
+			},
+			status: http.StatusBadGateway,
+			typ:    TypeUpstream,
+		},
+		{
+			name:   "rate limited",
+			method: http.MethodGet,
+			path:   "/friends",
+			upstream: func(rw http.ResponseWriter, r *http.Request) {
+				rw.WriteHeader(http.StatusTooManyRequests)
+			},
+			status: http.StatusTooManyRequests,
+			typ:    TypeRateLimited,
+		},
+		{
+			name:   "invalid json",
+			method: http.MethodPost,
+			path:   "/friends",
+			body:   `{"email": 5}`,
+			status: http.StatusBadRequest,
+			typ:    TypeInvalidRequest,
+		},
+		{
+			name:   "unknown field",
+			method: http.MethodPost,
+			path:   "/expenses",
+			body:   `{"cost": "1.00", "description": "x", "group": 1}`,
+			status: http.StatusBadRequest,
+			typ:    TypeInvalidRequest,
+		},
+		{
+			name:   "unknown group type",
+			method: http.MethodPost,
+			path:   "/groups",
+			body:   `{"name": "Flat", "group_type": "castle"}`,
+			status: http.StatusBadRequest,
+			typ:    TypeInvalidRequest,
+		},
+		{
+			name:   "invalid idempotency key",
+			method: http.MethodPost,
+			path:   "/expenses",
+			body:   `{"cost": "1.00", "description": "x", "group_id": 1, "idempotency_key": "a]b"}`,
+			status: http.StatusBadRequest,
+			typ:    TypeInvalidRequest,
+		},
+		{
+			name:   "invalid query",
+			method: http.MethodGet,
+			path:   "/expenses?dated_after=yesterday",
+			status: http.StatusBadRequest,
+			typ:    TypeInvalidRequest,
+		},
+		{
+			name:   "unknown route",
+			method: http.MethodGet,
+			path:   "/expenses/abc",
+			status: http.StatusNotFound,
+			typ:    TypeNotFound,
+		},
+		{
+			name:   "method not allowed",
+			method: http.MethodPut,
+			path:   "/expenses/5",
+			status: http.StatusMethodNotAllowed,
+			typ:    TypeMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			upstream := tt.upstream
+			if upstream == nil {
+				upstream = func(rw http.ResponseWriter, r *http.Request) {
+					t.Errorf("unexpected upstream request %s", r.URL.Path)
+				}
+			}
+			s, _ := newTestServer(t, upstream)
+			rec := serve(s, tt.method, tt.path, tt.body)
+			if rec.Code != tt.status {
+				t.Fatalf("expected status %d, got %d: %s", tt.status, rec.Code, rec.Body.String())
+			}
+			if p := decodeProblem(t, rec); p.Type != tt.typ {
+				t.Errorf("expected a problem of type %s, got %+v", tt.typ, p)
+			}
+		})
+	}
+}
+
+func TestListExpensesQuery(t *testing.T) {
+	var query url.Values
+	s, _ := newTestServer(t, func(rw http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		rw.Write([]byte(`{"expenses": [{"id": 1}, {"id": 2}]}`))
+	})
+	rec := serve(s, http.MethodGet, "/expenses?group_id=7&dated_after=2021-03-01&limit=2", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("unexpected status %d: %s", rec.Code, rec.Body.String())
+	}
+	if query.Get("group_id") != "7" || query.Get("dated_after") != "2021-03-01" || query.Get("limit") != "2" {
+		t.Errorf("unexpected upstream query: %v", query)
+	}
+	body, _ := ioutil.ReadAll(rec.Body)
+	var res struct {
+		Expenses []splitwise.Expense `json:"expenses"`
+	}
+	if err := json.Unmarshal(body, &res); err != nil || len(res.Expenses) != 2 {
+		t.Errorf("unexpected response %s (%v)", body, err)
+	}
+}
@@ -0,0 +1,369 @@
+package gateway
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+)
+
+func (s *Server) buildRoutes() []route {
+	routes := []struct {
+		method  string
+		pattern string
+		handle  handlerFunc
+	}{
+		{http.MethodGet, "me", s.getCurrentUser},
+		{http.MethodGet, "users/{id}", s.getUser},
+		{http.MethodGet, "categories", s.getCategories},
+		{http.MethodGet, "currencies", s.getCurrencies},
+		{http.MethodGet, "groups", s.getGroups},
+		{http.MethodPost, "groups", s.createGroup},
+		{http.MethodGet, "groups/{id}", s.getGroup},
+		{http.MethodDelete, "groups/{id}", s.deleteGroup},
+		{http.MethodPost, "groups/{id}/restore", s.restoreGroup},
+		{http.MethodPost, "groups/{id}/members", s.addGroupMember},
+		{http.MethodDelete, "groups/{id}/members/{user_id}", s.removeGroupMember},
+		{http.MethodGet, "friends", s.getFriends},
+		{http.MethodPost, "friends", s.createFriend},
+		{http.MethodGet, "friends/{id}", s.getFriend},
+		{http.MethodDelete, "friends/{id}", s.deleteFriend},
+		{http.MethodGet, "expenses", s.getExpenses},
+		{http.MethodPost, "expenses", s.createExpense},
+		{http.MethodGet, "expenses/{id}", s.getExpense},
+		{http.MethodDelete, "expenses/{id}", s.deleteExpense},
+		{http.MethodPost, "expenses/{id}/restore", s.restoreExpense},
+		{http.MethodGet, "expenses/{id}/comments", s.getComments},
+		{http.MethodPost, "expenses/{id}/comments", s.createComment},
+		{http.MethodDelete, "comments/{id}", s.deleteComment},
+	}
+	out := make([]route, len(routes))
+	for i, rt := range routes {
+		out[i] = route{rt.method, strings.Split(rt.pattern, "/"), rt.handle}
+	}
+	return out
+}
+
+// User references an existing user by ID, or a new user by name and email.
+type User struct {
+	UserID    int    `json:"user_id,omitempty"`
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+	Email     string `json:"email,omitempty"`
+}
+
+func (u User) option() (splitwise.UserOption, error) {
+	if u.UserID != 0 {
+		return splitwise.ExistingUser(u.UserID), nil
+	}
+	if u.Email == "" {
+		return nil, invalid("each user needs a user_id or an email")
+	}
+	return splitwise.NewUser(splitwise.CreateFriendRequest{
+		FirstName: u.FirstName,
+		LastName:  u.LastName,
+		Email:     u.Email,
+	}), nil
+}
+
+// Share is the part of an expense paid and owed by a user.
+type Share struct {
+	User
+	PaidShare string `json:"paid_share"`
+	OwedShare string `json:"owed_share"`
+}
+
+// CreateExpenseRequest is the body of POST /expenses.
+//
+// If Users is empty the expense is split equally between the members of the group,
+// and paid for by the current user.
+type CreateExpenseRequest struct {
+	Cost           string     `json:"cost"`
+	Description    string     `json:"description"`
+	Payment        bool       `json:"payment"`
+	GroupID        *int       `json:"group_id"`
+	Users          []Share    `json:"users"`
+	Details        *string    `json:"details"`
+	Date           *time.Time `json:"date"`
+	CurrencyCode   *string    `json:"currency_code"`
+	CategoryID     *int       `json:"category_id"`
+	IdempotencyKey string     `json:"idempotency_key"`
+}
+
+// CreateGroupRequest is the body of POST /groups.
+//
+// If Users is empty the group is created with just the current user.
+type CreateGroupRequest struct {
+	Name              string              `json:"name"`
+	Whiteboard        string              `json:"whiteboard"`
+	GroupType         splitwise.GroupType `json:"group_type"`
+	SimplifyByDefault bool                `json:"simplify_by_default"`
+	Users             []User              `json:"users"`
+}
+
+// CreateFriendRequest is the body of POST /friends.
+type CreateFriendRequest struct {
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Email     string `json:"email"`
+}
+
+// CreateCommentRequest is the body of POST /expenses/{id}/comments.
+type CreateCommentRequest struct {
+	Content string `json:"content"`
+}
+
+func (s *Server) getCurrentUser(r *http.Request, params []int) (int, interface{}, error) {
+	user, err := s.client.GetCurrentUser(r.Context())
+	return http.StatusOK, user, err
+}
+
+func (s *Server) getUser(r *http.Request, params []int) (int, interface{}, error) {
+	user, err := s.client.GetUser(r.Context(), params[0])
+	return http.StatusOK, user, err
+}
+
+func (s *Server) getCategories(r *http.Request, params []int) (int, interface{}, error) {
+	res, err := s.client.GetCategories(r.Context())
+	return http.StatusOK, res, err
+}
+
+func (s *Server) getCurrencies(r *http.Request, params []int) (int, interface{}, error) {
+	currencies, err := s.client.GetCurrencies(r.Context())
+	return http.StatusOK, map[string]interface{}{"currencies": currencies}, err
+}
+
+func (s *Server) getGroups(r *http.Request, params []int) (int, interface{}, error) {
+	groups, err := s.client.GetGroups(r.Context())
+	return http.StatusOK, map[string]interface{}{"groups": groups}, err
+}
+
+func (s *Server) getGroup(r *http.Request, params []int) (int, interface{}, error) {
+	group, err := s.client.GetGroup(r.Context(), params[0])
+	return http.StatusOK, group, err
+}
+
+func (s *Server) createGroup(r *http.Request, params []int) (int, interface{}, error) {
+	var req CreateGroupRequest
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	if req.Name == "" {
+		return 0, nil, invalid("name is required")
+	}
+	if !req.GroupType.Known() {
+		return 0, nil, invalid("group_type must be one of apartment, house, trip or other")
+	}
+	var users []splitwise.UserOption
+	for _, u := range req.Users {
+		opt, err := u.option()
+		if err != nil {
+			return 0, nil, err
+		}
+		users = append(users, opt)
+	}
+	if len(users) == 0 {
+		me, err := s.client.GetCurrentUser(r.Context())
+		if err != nil {
+			return 0, nil, err
+		}
+		users = append(users, splitwise.ExistingUser(me.ID))
+	}
+	group, err := s.client.CreateGroup(r.Context(), splitwise.CreateGroupRequest{
+		Name:              req.Name,
+		Whiteboard:        req.Whiteboard,
+		GroupType:         req.GroupType,
+		SimplifyByDefault: req.SimplifyByDefault,
+	}, users[0], users[1:]...)
+	return http.StatusCreated, group, err
+}
+
+func (s *Server) deleteGroup(r *http.Request, params []int) (int, interface{}, error) {
+	return http.StatusNoContent, nil, s.client.DeleteGroup(r.Context(), params[0])
+}
+
+func (s *Server) restoreGroup(r *http.Request, params []int) (int, interface{}, error) {
+	return http.StatusNoContent, nil, s.client.UndeleteGroup(r.Context(), params[0])
+}
+
+func (s *Server) addGroupMember(r *http.Request, params []int) (int, interface{}, error) {
+	var req User
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	opt, err := req.option()
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusNoContent, nil, s.client.AddUserToGroup(r.Context(), params[0], opt)
+}
+
+func (s *Server) removeGroupMember(r *http.Request, params []int) (int, interface{}, error) {
+	return http.StatusNoContent, nil, s.client.RemoveUserFromGroup(r.Context(), params[0], params[1])
+}
+
+func (s *Server) getFriends(r *http.Request, params []int) (int, interface{}, error) {
+	friends, err := s.client.GetFriends(r.Context())
+	return http.StatusOK, map[string]interface{}{"friends": friends}, err
+}
+
+func (s *Server) getFriend(r *http.Request, params []int) (int, interface{}, error) {
+	friend, err := s.client.GetFriend(r.Context(), params[0])
+	return http.StatusOK, friend, err
+}
+
+func (s *Server) createFriend(r *http.Request, params []int) (int, interface{}, error) {
+	var req CreateFriendRequest
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	if req.Email == "" {
+		return 0, nil, invalid("email is required")
+	}
+	friend, err := s.client.CreateFriend(r.Context(), &splitwise.CreateFriendRequest{
+		FirstName: req.FirstName,
+		LastName:  req.LastName,
+		Email:     req.Email,
+	})
+	return http.StatusCreated, friend, err
+}
+
+func (s *Server) deleteFriend(r *http.Request, params []int) (int, interface{}, error) {
+	return http.StatusNoContent, nil, s.client.DeleteFriend(r.Context(), params[0])
+}
+
+func (s *Server) getExpenses(r *http.Request, params []int) (int, interface{}, error) {
+	req, err := expensesQuery(r.URL.Query())
+	if err != nil {
+		return 0, nil, err
+	}
+	expenses, err := s.client.GetExpenses(r.Context(), req)
+	return http.StatusOK, map[string]interface{}{"expenses": expenses}, err
+}
+
+// expensesQuery parses the query parameters of GET /expenses.
+func expensesQuery(q url.Values) (*splitwise.GetExpensesRequest, error) {
+	req := &splitwise.GetExpensesRequest{}
+	ints := []struct {
+		name string
+		dst  **int
+	}{
+		{"group_id", &req.GroupID},
+		{"friend_id", &req.FriendID},
+	}
+	for _, p := range ints {
+		if v := q.Get(p.name); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, invalid(p.name + " must be an integer")
+			}
+			*p.dst = &n
+		}
+	}
+	times := []struct {
+		name   string
+		layout string
+		dst    **time.Time
+	}{
+		{"dated_after", "2006-01-02", &req.DatedAfter},
+		{"dated_before", "2006-01-02", &req.DatedBefore},
+		{"updated_after", time.RFC3339, &req.UpdatedAfter},
+		{"updated_before", time.RFC3339, &req.UpdatedBefore},
+	}
+	for _, p := range times {
+		if v := q.Get(p.name); v != "" {
+			t, err := time.Parse(p.layout, v)
+			if err != nil {
+				return nil, invalid(p.name + " must be formatted as " + p.layout)
+			}
+			*p.dst = &t
+		}
+	}
+	for name, dst := range map[string]*int{"limit": &req.Limit, "offset": &req.Offset} {
+		if v := q.Get(name); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 0 {
+				return nil, invalid(name + " must be a non-negative integer")
+			}
+			*dst = n
+		}
+	}
+	return req, nil
+}
+
+func (s *Server) getExpense(r *http.Request, params []int) (int, interface{}, error) {
+	expense, err := s.client.GetExpense(r.Context(), params[0])
+	return http.StatusOK, expense, err
+}
+
+func (s *Server) createExpense(r *http.Request, params []int) (int, interface{}, error) {
+	var req CreateExpenseRequest
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	if req.Cost == "" || req.Description == "" {
+		return 0, nil, invalid("cost and description are required")
+	}
+	out := splitwise.CreateExpenseRequest{
+		Cost:           req.Cost,
+		Description:    req.Description,
+		Payment:        req.Payment,
+		Details:        req.Details,
+		Date:           req.Date,
+		CurrencyCode:   req.CurrencyCode,
+		CategoryID:     req.CategoryID,
+		IdempotencyKey: req.IdempotencyKey,
+	}
+	if len(req.Users) == 0 {
+		if req.GroupID == nil {
+			return 0, nil, invalid("group_id is required when users are not given")
+		}
+		out.SplitStrategy = splitwise.SplitEqually(*req.GroupID)
+	} else {
+		shares := make([]splitwise.UserShare, len(req.Users))
+		for i, u := range req.Users {
+			opt, err := u.option()
+			if err != nil {
+				return 0, nil, err
+			}
+			shares[i] = splitwise.UserShare{UserOption: opt, PaidShare: u.PaidShare, OwedShare: u.OwedShare}
+		}
+		out.GroupID = req.GroupID
+		out.SplitStrategy = splitwise.SplitManually(shares...)
+	}
+	expense, err := s.client.CreateExpense(r.Context(), out)
+	return http.StatusCreated, expense, err
+}
+
+func (s *Server) deleteExpense(r *http.Request, params []int) (int, interface{}, error) {
+	return http.StatusNoContent, nil, s.client.DeleteExpense(r.Context(), params[0])
+}
+
+func (s *Server) restoreExpense(r *http.Request, params []int) (int, interface{}, error) {
+	return http.StatusNoContent, nil, s.client.UndeleteExpense(r.Context(), params[0])
+}
+
+func (s *Server) getComments(r *http.Request, params []int) (int, interface{}, error) {
+	comments, err := s.client.GetComments(r.Context(), params[0])
+	return http.StatusOK, map[string]interface{}{"comments": comments}, err
+}
+
+func (s *Server) createComment(r *http.Request, params []int) (int, interface{}, error) {
+	var req CreateCommentRequest
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	if req.Content == "" {
+		return 0, nil, invalid("content is required")
+	}
+	comment, err := s.client.CreateComment(r.Context(), params[0], req.Content)
+	return http.StatusCreated, comment, err
+}
+
+func (s *Server) deleteComment(r *http.Request, params []int) (int, interface{}, error) {
+	_, err := s.client.DeleteComment(r.Context(), params[0])
+	return http.StatusNoContent, nil, err
+}
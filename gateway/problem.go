@@ -0,0 +1,101 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+)
+
+// Problem is an error response in the format of RFC 7807, served with the media type
+// application/problem+json.
+type Problem struct {
+	// Type identifies the kind of problem, e.g. "urn:splitwise-gateway:api-error".
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Detail string `json:"detail,omitempty"`
+	// Errors lists the individual errors reported by splitwise, if any.
+	Errors []string `json:"errors,omitempty"`
+}
+
+func (p *Problem) Error() string {
+	if p.Detail != "" {
+		return p.Title + ": " + p.Detail
+	}
+	return p.Title
+}
+
+// The types of Problem returned by the gateway.
+const (
+	TypeUnauthorized     = "urn:splitwise-gateway:unauthorized"
+	TypeInvalidRequest   = "urn:splitwise-gateway:invalid-request"
+	TypeNotFound         = "urn:splitwise-gateway:not-found"
+	TypeMethodNotAllowed = "urn:splitwise-gateway:method-not-allowed"
+	TypeAPIError         = "urn:splitwise-gateway:api-error"
+	TypeRateLimited      = "urn:splitwise-gateway:rate-limited"
+	TypeUpstream         = "urn:splitwise-gateway:upstream-error"
+	TypeTimeout          = "urn:splitwise-gateway:timeout"
+)
+
+func invalid(detail string) *Problem {
+	return &Problem{Type: TypeInvalidRequest, Title: "Invalid request", Status: http.StatusBadRequest, Detail: detail}
+}
+
+// problemFor maps an error returned by the client to a Problem.
+//
+// Errors reported by splitwise in the response body become 422s, while unexpected
+// statuses are passed through for not found and rate limiting only. Any other failure
+// of the upstream API, including rejected credentials, is a fault of the gateway
+// rather than the caller and so is reported as a 502.
+func problemFor(err error) *Problem {
+	var p *Problem
+	if errors.As(err, &p) {
+		return p
+	}
+	if errors.Is(err, splitwise.ErrInvalidIdempotencyKey) {
+		return invalid(err.Error())
+	}
+	var apiErr *splitwise.APIError
+	if errors.As(err, &apiErr) {
+		return &Problem{
+			Type:   TypeAPIError,
+			Title:  "Rejected by splitwise",
+			Status: http.StatusUnprocessableEntity,
+			Errors: apiErr.Errors(),
+		}
+	}
+	var status splitwise.UnexpectedStatus
+	if errors.As(err, &status) {
+		switch status.Status {
+		case http.StatusNotFound:
+			return &Problem{Type: TypeNotFound, Title: "Not found", Status: http.StatusNotFound}
+		case http.StatusTooManyRequests:
+			return &Problem{Type: TypeRateLimited, Title: "Rate limited by splitwise", Status: http.StatusTooManyRequests}
+		}
+		return &Problem{
+			Type:   TypeUpstream,
+			Title:  "Upstream error",
+			Status: http.StatusBadGateway,
+			Detail: status.Error(),
+		}
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return &Problem{Type: TypeTimeout, Title: "Upstream timeout", Status: http.StatusGatewayTimeout}
+	}
+	return &Problem{
+		Type:   TypeUpstream,
+		Title:  "Upstream error",
+		Status: http.StatusBadGateway,
+		Detail: "the request to splitwise failed",
+	}
+}
+
+func writeProblem(rw http.ResponseWriter, p *Problem) {
+	rw.Header().Set("Content-Type", "application/problem+json")
+	rw.Header().Set("X-Content-Type-Options", "nosniff")
+	rw.WriteHeader(p.Status)
+	json.NewEncoder(rw).Encode(p)
+}
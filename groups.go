@@ -2,15 +2,15 @@ package splitwise
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
-	"strconv"
 	"time"
 )
 
 type Group struct {
+	extraFields
+
 	ID                int           `json:"id"`
 	Name              string        `json:"name"`
 	UpdatedAt         *time.Time    `json:"updated_at"`
@@ -20,48 +20,6 @@ type Group struct {
 	GroupType         GroupType     `json:"group_type"`
 }
 
-type GroupType int
-
-const (
-	GroupTypeOther GroupType = iota
-	GroupTypeApartment
-	GroupTypeHouse
-	GroupTypeTrip
-)
-
-func (gt GroupType) String() string {
-	return []string{
-		"other",
-		"apartment",
-		"house",
-		"trip",
-	}[gt]
-}
-
-func (gt *GroupType) UnmarshalJSON(bytes []byte) error {
-	var name string
-	if err := json.Unmarshal(bytes, &name); err != nil {
-		return err
-	}
-	switch name {
-	case "other":
-		*gt = GroupTypeOther
-	case "apartment":
-		*gt = GroupTypeApartment
-	case "house":
-		*gt = GroupTypeHouse
-	case "trip":
-		*gt = GroupTypeTrip
-	default:
-		return fmt.Errorf("unknown group: '%s'", name)
-	}
-	return nil
-}
-
-func (gt GroupType) MarshalJSON() ([]byte, error) {
-	return json.Marshal(gt.String())
-}
-
 type GroupMember struct {
 	ID                int           `json:"id"`
 	FirstName         string        `json:"first_name"`
@@ -133,12 +91,9 @@ func (c *Client) GetGroup(ctx context.Context, id int) (*Group, error) {
 
 func (c *Client) CreateGroup(ctx context.Context, req CreateGroupRequest, user UserOption, users ...UserOption) (*Group, error) {
 	rw := newRequest()
-
-	rw.Str("name", req.Name)
-	rw.Str("whiteboard", req.Whiteboard)
-	rw.Str("group_type", req.GroupType.String())
-	rw.Bool("simplify_by_default", req.SimplifyByDefault)
-
+	if err := encodeRequest(rw, &req); err != nil {
+		return nil, err
+	}
 	arr := rw.Array("users")
 	user.prepareRequest(arr)
 	arr.Next()
@@ -147,26 +102,26 @@ func (c *Client) CreateGroup(ctx context.Context, req CreateGroupRequest, user U
 		arr.Next()
 	}
 	var res struct {
-		Group  Group    `json:"group"`
-		Errors APIError `json:"errors"`
+		Group Group `json:"group"`
+		errorsResponse
 	}
 	err := c.do(
 		ctx,
 		http.MethodPost,
 		&url.URL{Path: "create_group"},
-		rw.Values,
+		&rw,
 		&res,
 	)
-	if res.Errors.Len() > 0 {
-		return nil, &res.Errors
+	if err != nil {
+		return nil, err
 	}
-	return &res.Group, err
+	return &res.Group, nil
 }
 
 func (c *Client) DeleteGroup(ctx context.Context, id int) error {
 	var res struct {
-		Success bool     `json:"success"`
-		Errors  APIError `json:"errors"`
+		Success bool `json:"success"`
+		errorsResponse
 	}
 	err := c.do(
 		ctx,
@@ -186,8 +141,8 @@ func (c *Client) DeleteGroup(ctx context.Context, id int) error {
 
 func (c *Client) UndeleteGroup(ctx context.Context, id int) error {
 	var res struct {
-		Success bool     `json:"success"`
-		Errors  APIError `json:"errors"`
+		Success bool `json:"success"`
+		errorsResponse
 	}
 	err := c.do(
 		ctx,
@@ -210,14 +165,14 @@ func (c *Client) AddUserToGroup(ctx context.Context, id int, user UserOption) er
 	rw.Int("group_id", id)
 	user.prepareRequest(rw)
 	var res struct {
-		Success bool     `json:"success"`
-		Errors  APIError `json:"errors"`
+		Success bool `json:"success"`
+		errorsResponse
 	}
 	err := c.do(
 		ctx,
 		http.MethodPost,
 		&url.URL{Path: "add_user_to_group"},
-		rw.Values,
+		&rw,
 		&res,
 	)
 	if err != nil {
@@ -230,19 +185,18 @@ func (c *Client) AddUserToGroup(ctx context.Context, id int, user UserOption) er
 }
 
 func (c *Client) RemoveUserFromGroup(ctx context.Context, id int, userID int) error {
-	req := url.Values{
-		"group_id": []string{strconv.Itoa(id)},
-		"user_id":  []string{strconv.Itoa(userID)},
-	}
+	rw := newRequest()
+	rw.Int("group_id", id)
+	rw.Int("user_id", userID)
 	var res struct {
-		Success bool     `json:"success"`
-		Errors  APIError `json:"errors"`
+		Success bool `json:"success"`
+		errorsResponse
 	}
 	err := c.do(
 		ctx,
 		http.MethodPost,
 		&url.URL{Path: "remove_user_from_group"},
-		req,
+		&rw,
 		&res,
 	)
 	if err != nil {
@@ -0,0 +1,135 @@
+package grpcsplitwise
+
+import (
+	splitwise "github.com/cwbriones/go-splitwise"
+	"github.com/cwbriones/go-splitwise/grpcsplitwise/splitwisepb"
+)
+
+func userToProto(u *splitwise.User) *splitwisepb.User {
+	return &splitwisepb.User{
+		Id:                 int64(u.ID),
+		FirstName:          u.FirstName,
+		LastName:           u.LastName,
+		Email:              u.Email,
+		RegistrationStatus: u.Registration.String(),
+		Picture:            pictureToProto(u.Picture),
+		DefaultCurrency:    u.DefaultCurrency,
+		Locale:             u.Locale,
+	}
+}
+
+func pictureToProto(p splitwise.Picture) *splitwisepb.Picture {
+	if p == (splitwise.Picture{}) {
+		return nil
+	}
+	return &splitwisepb.Picture{Small: p.Small, Medium: p.Medium, Large: p.Large}
+}
+
+func balancesToProto(balances []splitwise.Balance) []*splitwisepb.Balance {
+	var out []*splitwisepb.Balance
+	for _, b := range balances {
+		out = append(out, &splitwisepb.Balance{CurrencyCode: b.CurrencyCode, Amount: b.Amount})
+	}
+	return out
+}
+
+func groupToProto(g *splitwise.Group) *splitwisepb.Group {
+	out := &splitwisepb.Group{
+		Id:                int64(g.ID),
+		Name:              g.Name,
+		GroupType:         g.GroupType.String(),
+		SimplifyByDefault: g.SimplifyByDefault,
+		UpdatedAt:         timeToProto(g.UpdatedAt),
+	}
+	for _, m := range g.Members {
+		out.Members = append(out.Members, &splitwisepb.GroupMember{
+			User: &splitwisepb.User{
+				Id:                 int64(m.ID),
+				FirstName:          m.FirstName,
+				LastName:           m.LastName,
+				Email:              m.Email,
+				RegistrationStatus: m.Registration.String(),
+				Picture:            pictureToProto(m.Picture),
+			},
+			Balance: balancesToProto(m.Balance),
+		})
+	}
+	for _, d := range g.OriginalDebts {
+		out.OriginalDebts = append(out.OriginalDebts, &splitwisepb.Debt{
+			From:         int64(d.From),
+			To:           int64(d.To),
+			Amount:       d.Amount,
+			CurrencyCode: d.CurrencyCode,
+		})
+	}
+	return out
+}
+
+func friendToProto(f *splitwise.Friend) *splitwisepb.Friend {
+	out := &splitwisepb.Friend{
+		Id:        int64(f.ID),
+		FirstName: f.FirstName,
+		LastName:  f.LastName,
+		Picture:   pictureToProto(f.Picture),
+		Balance:   balancesToProto(f.Balance),
+		UpdatedAt: timeToProto(f.UpdatedAt),
+	}
+	for _, g := range f.Groups {
+		out.Groups = append(out.Groups, &splitwisepb.GroupBalance{
+			GroupId: int64(g.GroupID),
+			Balance: balancesToProto(g.Balance),
+		})
+	}
+	return out
+}
+
+func expenseToProto(e *splitwise.Expense) *splitwisepb.Expense {
+	out := &splitwisepb.Expense{
+		Id:           int64(e.ID),
+		Description:  e.Description,
+		Details:      e.Details,
+		Cost:         e.Cost,
+		CurrencyCode: e.CurrencyCode,
+		Payment:      e.Payment,
+		Category:     &splitwisepb.Category{Id: int64(e.Category.ID), Name: e.Category.Name},
+		Date:         timeToProto(&e.Date),
+		CreatedAt:    timeToProto(&e.CreatedAt),
+		UpdatedAt:    timeToProto(&e.UpdatedAt),
+		DeletedAt:    timeToProto(e.DeletedAt),
+	}
+	if e.GroupID != nil {
+		id := int64(*e.GroupID)
+		out.GroupId = &id
+	}
+	for i := range e.Users {
+		u := &e.Users[i]
+		out.Users = append(out.Users, &splitwisepb.ExpenseShare{
+			UserId:     int64(u.UserID),
+			User:       userToProto(&u.User),
+			PaidShare:  u.PaidShare,
+			OwedShare:  u.OwedShare,
+			NetBalance: u.NetBalance,
+		})
+	}
+	for _, r := range e.Repayments {
+		out.Repayments = append(out.Repayments, &splitwisepb.Repayment{
+			From:   int64(r.From),
+			To:     int64(r.To),
+			Amount: r.Amount,
+		})
+	}
+	return out
+}
+
+func commentToProto(c *splitwise.Comment) *splitwisepb.Comment {
+	return &splitwisepb.Comment{
+		Id:           int64(c.ID),
+		Content:      c.Content,
+		CommentType:  c.CommentType,
+		RelationType: c.RelationType,
+		RelationId:   int64(c.RelationID),
+		CreatedAt:    timeToProto(c.CreatedAt),
+		DeletedAt:    timeToProto(c.DeletedAt),
+		User:         userToProto(&c.User),
+	}
+}
@@ -0,0 +1,16 @@
+module github.com/cwbriones/go-splitwise/grpcsplitwise
+
+go 1.26.0
+
+require (
+	github.com/cwbriones/go-splitwise v0.0.0-20261018193416-51067bbf2df1
+	google.golang.org/grpc v1.82.1
+	google.golang.org/protobuf v1.36.12
+)
+
+require (
+	golang.org/x/net v0.56.0 // indirect
+	golang.org/x/sys v0.47.0 // indirect
+	golang.org/x/text v0.40.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20260819154853-08b0e4226688 // indirect
+)
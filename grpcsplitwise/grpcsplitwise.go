@@ -0,0 +1,405 @@
+// Package grpcsplitwise serves the splitwise.v1.Splitwise gRPC service, defined in
+// proto/splitwise/v1/splitwise.proto, backed by a splitwise.Client.
+//
+//	s := grpc.NewServer()
+//	splitwisepb.RegisterSplitwiseServer(s, grpcsplitwise.NewServer(client))
+//
+// The generated stubs in splitwisepb are updated with go generate, which requires buf,
+// protoc-gen-go and protoc-gen-go-grpc.
+package grpcsplitwise
+
+//go:generate buf generate
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+	"github.com/cwbriones/go-splitwise/grpcsplitwise/splitwisepb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Server implements splitwisepb.SplitwiseServer using a splitwise.Client.
+type Server struct {
+	splitwisepb.UnimplementedSplitwiseServer
+
+	client *splitwise.Client
+}
+
+var _ splitwisepb.SplitwiseServer = (*Server)(nil)
+
+// NewServer returns a Server which performs each call with client.
+func NewServer(client *splitwise.Client) *Server {
+	return &Server{client: client}
+}
+
+// statusError converts an error returned by the client into a gRPC status.
+func statusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	var apiErr *splitwise.APIError
+	if errors.As(err, &apiErr) {
+		return status.Error(codes.InvalidArgument, apiErr.Error())
+	}
+	var unexpected splitwise.UnexpectedStatus
+	if errors.As(err, &unexpected) {
+		switch {
+		case unexpected.Status == http.StatusNotFound:
+			return status.Error(codes.NotFound, err.Error())
+		case unexpected.Status == http.StatusTooManyRequests:
+			return status.Error(codes.ResourceExhausted, err.Error())
+		case unexpected.Status == http.StatusUnauthorized:
+			return status.Error(codes.Unauthenticated, err.Error())
+		case unexpected.Status == http.StatusForbidden:
+			return status.Error(codes.PermissionDenied, err.Error())
+		case unexpected.Status >= 500:
+			return status.Error(codes.Unavailable, err.Error())
+		}
+		return status.Error(codes.Unknown, err.Error())
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+	return status.Error(codes.Unavailable, err.Error())
+}
+
+func (s *Server) GetCurrentUser(ctx context.Context, req *splitwisepb.GetCurrentUserRequest) (*splitwisepb.User, error) {
+	user, err := s.client.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return userToProto(user), nil
+}
+
+func (s *Server) GetUser(ctx context.Context, req *splitwisepb.GetUserRequest) (*splitwisepb.User, error) {
+	user, err := s.client.GetUser(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return userToProto(user), nil
+}
+
+func (s *Server) ListGroups(ctx context.Context, req *splitwisepb.ListGroupsRequest) (*splitwisepb.ListGroupsResponse, error) {
+	groups, err := s.client.GetGroups(ctx)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := &splitwisepb.ListGroupsResponse{}
+	for i := range groups {
+		res.Groups = append(res.Groups, groupToProto(&groups[i]))
+	}
+	return res, nil
+}
+
+func (s *Server) GetGroup(ctx context.Context, req *splitwisepb.GetGroupRequest) (*splitwisepb.Group, error) {
+	group, err := s.client.GetGroup(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return groupToProto(group), nil
+}
+
+func (s *Server) CreateGroup(ctx context.Context, req *splitwisepb.CreateGroupRequest) (*splitwisepb.Group, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	var groupType splitwise.GroupType
+	groupType.UnmarshalText([]byte(req.GetGroupType()))
+	if !groupType.Known() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid group type %q", req.GetGroupType())
+	}
+	var users []splitwise.UserOption
+	for _, ref := range req.GetUsers() {
+		opt, err := userOption(ref)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, opt)
+	}
+	if len(users) == 0 {
+		me, err := s.client.GetCurrentUser(ctx)
+		if err != nil {
+			return nil, statusError(err)
+		}
+		users = append(users, splitwise.ExistingUser(me.ID))
+	}
+	group, err := s.client.CreateGroup(ctx, splitwise.CreateGroupRequest{
+		Name:              req.GetName(),
+		Whiteboard:        req.GetWhiteboard(),
+		GroupType:         groupType,
+		SimplifyByDefault: req.GetSimplifyByDefault(),
+	}, users[0], users[1:]...)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return groupToProto(group), nil
+}
+
+func (s *Server) DeleteGroup(ctx context.Context, req *splitwisepb.DeleteGroupRequest) (*splitwisepb.DeleteGroupResponse, error) {
+	if err := s.client.DeleteGroup(ctx, int(req.GetId())); err != nil {
+		return nil, statusError(err)
+	}
+	return &splitwisepb.DeleteGroupResponse{}, nil
+}
+
+func (s *Server) UndeleteGroup(ctx context.Context, req *splitwisepb.UndeleteGroupRequest) (*splitwisepb.UndeleteGroupResponse, error) {
+	if err := s.client.UndeleteGroup(ctx, int(req.GetId())); err != nil {
+		return nil, statusError(err)
+	}
+	return &splitwisepb.UndeleteGroupResponse{}, nil
+}
+
+func (s *Server) AddUserToGroup(ctx context.Context, req *splitwisepb.AddUserToGroupRequest) (*splitwisepb.AddUserToGroupResponse, error) {
+	opt, err := userOption(req.GetUser())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.AddUserToGroup(ctx, int(req.GetGroupId()), opt); err != nil {
+		return nil, statusError(err)
+	}
+	return &splitwisepb.AddUserToGroupResponse{}, nil
+}
+
+func (s *Server) RemoveUserFromGroup(ctx context.Context, req *splitwisepb.RemoveUserFromGroupRequest) (*splitwisepb.RemoveUserFromGroupResponse, error) {
+	if err := s.client.RemoveUserFromGroup(ctx, int(req.GetGroupId()), int(req.GetUserId())); err != nil {
+		return nil, statusError(err)
+	}
+	return &splitwisepb.RemoveUserFromGroupResponse{}, nil
+}
+
+func (s *Server) ListFriends(ctx context.Context, req *splitwisepb.ListFriendsRequest) (*splitwisepb.ListFriendsResponse, error) {
+	friends, err := s.client.GetFriends(ctx)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := &splitwisepb.ListFriendsResponse{}
+	for i := range friends {
+		res.Friends = append(res.Friends, friendToProto(&friends[i]))
+	}
+	return res, nil
+}
+
+func (s *Server) GetFriend(ctx context.Context, req *splitwisepb.GetFriendRequest) (*splitwisepb.Friend, error) {
+	friend, err := s.client.GetFriend(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return friendToProto(friend), nil
+}
+
+func (s *Server) CreateFriend(ctx context.Context, req *splitwisepb.CreateFriendRequest) (*splitwisepb.Friend, error) {
+	user := req.GetUser()
+	if user.GetEmail() == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+	friend, err := s.client.CreateFriend(ctx, &splitwise.CreateFriendRequest{
+		FirstName: user.GetFirstName(),
+		LastName:  user.GetLastName(),
+		Email:     user.GetEmail(),
+	})
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return friendToProto(friend), nil
+}
+
+func (s *Server) DeleteFriend(ctx context.Context, req *splitwisepb.DeleteFriendRequest) (*splitwisepb.DeleteFriendResponse, error) {
+	if err := s.client.DeleteFriend(ctx, int(req.GetId())); err != nil {
+		return nil, statusError(err)
+	}
+	return &splitwisepb.DeleteFriendResponse{}, nil
+}
+
+func (s *Server) ListExpenses(req *splitwisepb.ListExpensesRequest, stream grpc.ServerStreamingServer[splitwisepb.Expense]) error {
+	list := &splitwise.GetExpensesRequest{
+		DatedAfter:    timeFromProto(req.GetDatedAfter()),
+		DatedBefore:   timeFromProto(req.GetDatedBefore()),
+		UpdatedAfter:  timeFromProto(req.GetUpdatedAfter()),
+		UpdatedBefore: timeFromProto(req.GetUpdatedBefore()),
+		Limit:         int(req.GetPageSize()),
+	}
+	if req.GroupId != nil {
+		id := int(req.GetGroupId())
+		list.GroupID = &id
+	}
+	if req.FriendId != nil {
+		id := int(req.GetFriendId())
+		list.FriendID = &id
+	}
+	limit := int(req.GetLimit())
+	// Pages larger than the limit would fetch expenses that are never sent.
+	if limit > 0 && (list.Limit <= 0 || list.Limit > limit) {
+		list.Limit = limit
+	}
+	it := s.client.Expenses(stream.Context(), list)
+	// The limit is checked before advancing, so that reaching it does not fetch
+	// another page.
+	for sent := 0; limit <= 0 || sent < limit; sent++ {
+		if !it.Next() {
+			break
+		}
+		expense := it.Expense()
+		if err := stream.Send(expenseToProto(&expense)); err != nil {
+			return err
+		}
+	}
+	return statusError(it.Err())
+}
+
+func (s *Server) GetExpense(ctx context.Context, req *splitwisepb.GetExpenseRequest) (*splitwisepb.Expense, error) {
+	expense, err := s.client.GetExpense(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return expenseToProto(expense), nil
+}
+
+func (s *Server) CreateExpense(ctx context.Context, req *splitwisepb.CreateExpenseRequest) (*splitwisepb.Expense, error) {
+	if req.GetCost() == "" || req.GetDescription() == "" {
+		return nil, status.Error(codes.InvalidArgument, "cost and description are required")
+	}
+	create := splitwise.CreateExpenseRequest{
+		Cost:           req.GetCost(),
+		Description:    req.GetDescription(),
+		Payment:        req.GetPayment(),
+		Details:        req.Details,
+		Date:           timeFromProto(req.GetDate()),
+		CurrencyCode:   req.CurrencyCode,
+		IdempotencyKey: req.GetIdempotencyKey(),
+	}
+	if req.CategoryId != nil {
+		id := int(req.GetCategoryId())
+		create.CategoryID = &id
+	}
+	if len(req.GetShares()) == 0 {
+		if req.GroupId == nil {
+			return nil, status.Error(codes.InvalidArgument, "group_id is required when shares are not given")
+		}
+		create.SplitStrategy = splitwise.SplitEqually(int(req.GetGroupId()))
+	} else {
+		shares := make([]splitwise.UserShare, len(req.GetShares()))
+		for i, share := range req.GetShares() {
+			opt, err := userOption(share.GetUser())
+			if err != nil {
+				return nil, err
+			}
+			shares[i] = splitwise.UserShare{
+				UserOption: opt,
+				PaidShare:  share.GetPaidShare(),
+				OwedShare:  share.GetOwedShare(),
+			}
+		}
+		if req.GroupId != nil {
+			id := int(req.GetGroupId())
+			create.GroupID = &id
+		}
+		create.SplitStrategy = splitwise.SplitManually(shares...)
+	}
+	expense, err := s.client.CreateExpense(ctx, create)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return expenseToProto(expense), nil
+}
+
+func (s *Server) DeleteExpense(ctx context.Context, req *splitwisepb.DeleteExpenseRequest) (*splitwisepb.DeleteExpenseResponse, error) {
+	if err := s.client.DeleteExpense(ctx, int(req.GetId())); err != nil {
+		return nil, statusError(err)
+	}
+	return &splitwisepb.DeleteExpenseResponse{}, nil
+}
+
+func (s *Server) UndeleteExpense(ctx context.Context, req *splitwisepb.UndeleteExpenseRequest) (*splitwisepb.UndeleteExpenseResponse, error) {
+	if err := s.client.UndeleteExpense(ctx, int(req.GetId())); err != nil {
+		return nil, statusError(err)
+	}
+	return &splitwisepb.UndeleteExpenseResponse{}, nil
+}
+
+func (s *Server) ListComments(ctx context.Context, req *splitwisepb.ListCommentsRequest) (*splitwisepb.ListCommentsResponse, error) {
+	comments, err := s.client.GetComments(ctx, int(req.GetExpenseId()))
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := &splitwisepb.ListCommentsResponse{}
+	for i := range comments {
+		res.Comments = append(res.Comments, commentToProto(&comments[i]))
+	}
+	return res, nil
+}
+
+func (s *Server) CreateComment(ctx context.Context, req *splitwisepb.CreateCommentRequest) (*splitwisepb.Comment, error) {
+	if req.GetContent() == "" {
+		return nil, status.Error(codes.InvalidArgument, "content is required")
+	}
+	comment, err := s.client.CreateComment(ctx, int(req.GetExpenseId()), req.GetContent())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return commentToProto(comment), nil
+}
+
+func (s *Server) DeleteComment(ctx context.Context, req *splitwisepb.DeleteCommentRequest) (*splitwisepb.Comment, error) {
+	comment, err := s.client.DeleteComment(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return commentToProto(comment), nil
+}
+
+func (s *Server) ListCategories(ctx context.Context, req *splitwisepb.ListCategoriesRequest) (*splitwisepb.ListCategoriesResponse, error) {
+	res, err := s.client.GetCategories(ctx)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	out := &splitwisepb.ListCategoriesResponse{}
+	for _, c := range res.Categories {
+		category := &splitwisepb.Category{Id: int64(c.ID), Name: c.Name}
+		for _, sub := range c.Subcategories {
+			category.Subcategories = append(category.Subcategories, &splitwisepb.Category{Id: int64(sub.ID), Name: sub.Name})
+		}
+		out.Categories = append(out.Categories, category)
+	}
+	return out, nil
+}
+
+func userOption(ref *splitwisepb.UserRef) (splitwise.UserOption, error) {
+	switch u := ref.GetUser().(type) {
+	case *splitwisepb.UserRef_UserId:
+		return splitwise.ExistingUser(int(u.UserId)), nil
+	case *splitwisepb.UserRef_NewUser:
+		if u.NewUser.GetEmail() == "" {
+			return nil, status.Error(codes.InvalidArgument, "new users require an email")
+		}
+		return splitwise.NewUser(splitwise.CreateFriendRequest{
+			FirstName: u.NewUser.GetFirstName(),
+			LastName:  u.NewUser.GetLastName(),
+			Email:     u.NewUser.GetEmail(),
+		}), nil
+	}
+	return nil, status.Error(codes.InvalidArgument, "user_id or new_user is required")
+}
+
+func timeFromProto(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+func timeToProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,249 @@
+package grpcsplitwise
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+	"github.com/cwbriones/go-splitwise/grpcsplitwise/splitwisepb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newTestClient serves the Splitwise service in-process, backed by a fake splitwise
+// API, and returns a client connected to it.
+func newTestClient(t *testing.T, api http.HandlerFunc) splitwisepb.SplitwiseClient {
+	upstream := httptest.NewServer(api)
+	t.Cleanup(upstream.Close)
+	u, _ := url.Parse(upstream.URL + "/api/v3.0/")
+	client := splitwise.NewClient(upstream.Client(), splitwise.WithBaseURL(u))
+
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	splitwisepb.RegisterSplitwiseServer(server, NewServer(client))
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return splitwisepb.NewSplitwiseClient(conn)
+}
+
+func TestGetGroup(t *testing.T) {
+	client := newTestClient(t, func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v3.0/get_group/10" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		rw.Write([]byte(`{"group": {
+			"id": 10, "name": "Flat", "group_type": "apartment",
+			"members": [{"id": 1, "first_name": "Ada", "registration_status": "confirmed",
+			             "balance": [{"currency_code": "GBP", "amount": "12.50"}]}]
+		}}`))
+	})
+	group, err := client.GetGroup(context.Background(), &splitwisepb.GetGroupRequest{Id: 10})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if group.GetName() != "Flat" || group.GetGroupType() != "apartment" || len(group.GetMembers()) != 1 {
+		t.Fatalf("unexpected group: %v", group)
+	}
+	member := group.GetMembers()[0]
+	if member.GetUser().GetFirstName() != "Ada" || member.GetBalance()[0].GetAmount() != "12.50" {
+		t.Errorf("unexpected member: %v", member)
+	}
+
+	_, err = client.GetGroup(context.Background(), &splitwisepb.GetGroupRequest{Id: 11})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound, got %v", err)
+	}
+}
+
+func TestListExpensesStreamsPages(t *testing.T) {
+	var pages int
+	client := newTestClient(t, func(rw http.ResponseWriter, r *http.Request) {
+		pages++
+		q := r.URL.Query()
+		if q.Get("group_id") != "10" {
+			t.Errorf("expected the group to be filtered, got %v", q)
+		}
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		// Serve five expenses in total.
+		fmt.Fprint(rw, `{"expenses": [`)
+		for i := offset; i < offset+limit && i < 5; i++ {
+			if i > offset {
+				fmt.Fprint(rw, ",")
+			}
+			fmt.Fprintf(rw, `{"id": %d, "group_id": 10, "date": "2021-03-01T00:00:00Z"}`, i+1)
+		}
+		fmt.Fprint(rw, `]}`)
+	})
+
+	groupID := int64(10)
+	stream, err := client.ListExpenses(context.Background(), &splitwisepb.ListExpensesRequest{
+		GroupId:  &groupID,
+		PageSize: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []int64
+	for {
+		expense, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if expense.GetGroupId() != 10 || expense.GetDate().AsTime().Day() != 1 {
+			t.Errorf("unexpected expense: %v", expense)
+		}
+		ids = append(ids, expense.GetId())
+	}
+	if len(ids) != 5 || ids[0] != 1 || ids[4] != 5 {
+		t.Errorf("unexpected expenses %v", ids)
+	}
+	if pages != 3 {
+		t.Errorf("expected 3 pages, got %d", pages)
+	}
+}
+
+func TestListExpensesLimit(t *testing.T) {
+	tests := []struct {
+		pageSize, limit int32
+		pages, sent     int
+		firstPageSize   string
+	}{
+		{pageSize: 2, limit: 3, pages: 2, sent: 3, firstPageSize: "2"},
+		{pageSize: 2, limit: 2, pages: 1, sent: 2, firstPageSize: "2"},
+		{pageSize: 0, limit: 3, pages: 1, sent: 3, firstPageSize: "3"},
+		{pageSize: 10, limit: 3, pages: 1, sent: 3, firstPageSize: "3"},
+	}
+	for _, tt := range tests {
+		var pages []string
+		client := newTestClient(t, func(rw http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			pages = append(pages, q.Get("limit"))
+			offset, _ := strconv.Atoi(q.Get("offset"))
+			limit, _ := strconv.Atoi(q.Get("limit"))
+			// Serve ten expenses in total.
+			fmt.Fprint(rw, `{"expenses": [`)
+			for i := offset; i < offset+limit && i < 10; i++ {
+				if i > offset {
+					fmt.Fprint(rw, ",")
+				}
+				fmt.Fprintf(rw, `{"id": %d}`, i+1)
+			}
+			fmt.Fprint(rw, `]}`)
+		})
+		stream, err := client.ListExpenses(context.Background(), &splitwisepb.ListExpensesRequest{
+			PageSize: tt.pageSize,
+			Limit:    tt.limit,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var sent int
+		for {
+			_, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			sent++
+		}
+		if sent != tt.sent || len(pages) != tt.pages || pages[0] != tt.firstPageSize {
+			t.Errorf("page size %d, limit %d: expected %d expenses in %d pages of %s, got %d in pages %v",
+				tt.pageSize, tt.limit, tt.sent, tt.pages, tt.firstPageSize, sent, pages)
+		}
+	}
+}
+
+func TestCreateExpense(t *testing.T) {
+	client := newTestClient(t, func(rw http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.PostForm.Get("users__1__email") != "grace@example.com" || r.PostForm.Get("group_id") != "10" {
+			rw.Write([]byte(`{"errors": {"base": ["Invalid users"]}}`))
+			return
+		}
+		rw.Write([]byte(`{"expense": {"id": 42, "description": "Pizza", "cost": "10.00"}}`))
+	})
+	groupID := int64(10)
+	req := &splitwisepb.CreateExpenseRequest{
+		Cost:        "10.00",
+		Description: "Pizza",
+		GroupId:     &groupID,
+		Shares: []*splitwisepb.Share{
+			{
+				User:      &splitwisepb.UserRef{User: &splitwisepb.UserRef_UserId{UserId: 1}},
+				PaidShare: "10.00",
+				OwedShare: "5.00",
+			},
+			{
+				User: &splitwisepb.UserRef{User: &splitwisepb.UserRef_NewUser{
+					NewUser: &splitwisepb.NewUser{FirstName: "Grace", Email: "grace@example.com"},
+				}},
+				PaidShare: "0.00",
+				OwedShare: "5.00",
+			},
+		},
+	}
+	expense, err := client.CreateExpense(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expense.GetId() != 42 {
+		t.Errorf("unexpected expense: %v", expense)
+	}
+
+	req.GroupId = nil
+	_, err = client.CreateExpense(context.Background(), req)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected the API error to be InvalidArgument, got %v", err)
+	}
+}
+
+func TestStatusCodes(t *testing.T) {
+	tests := []struct {
+		status int
+		want   codes.Code
+	}{
+		{http.StatusUnauthorized, codes.Unauthenticated},
+		{http.StatusForbidden, codes.PermissionDenied},
+		{http.StatusNotFound, codes.NotFound},
+		{http.StatusTooManyRequests, codes.ResourceExhausted},
+		{http.StatusBadGateway, codes.Unavailable},
+	}
+	for _, tt := range tests {
+		client := newTestClient(t, func(rw http.ResponseWriter, r *http.Request) {
+			rw.WriteHeader(tt.status)
+		})
+		_, err := client.GetGroup(context.Background(), &splitwisepb.GetGroupRequest{Id: 10})
+		if status.Code(err) != tt.want {
+			t.Errorf("HTTP %d: expected %s, got %v", tt.status, tt.want, err)
+		}
+	}
+}
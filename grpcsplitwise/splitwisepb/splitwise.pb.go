@@ -0,0 +1,3226 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: splitwise/v1/splitwise.proto
+
+package splitwisepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type User struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstName string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName  string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Email     string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	// One of "dummy", "confirmed" or "invited".
+	RegistrationStatus string   `protobuf:"bytes,5,opt,name=registration_status,json=registrationStatus,proto3" json:"registration_status,omitempty"`
+	Picture            *Picture `protobuf:"bytes,6,opt,name=picture,proto3" json:"picture,omitempty"`
+	// Only set for the current user.
+	DefaultCurrency string `protobuf:"bytes,7,opt,name=default_currency,json=defaultCurrency,proto3" json:"default_currency,omitempty"`
+	Locale          string `protobuf:"bytes,8,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *User) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *User) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *User) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetRegistrationStatus() string {
+	if x != nil {
+		return x.RegistrationStatus
+	}
+	return ""
+}
+
+func (x *User) GetPicture() *Picture {
+	if x != nil {
+		return x.Picture
+	}
+	return nil
+}
+
+func (x *User) GetDefaultCurrency() string {
+	if x != nil {
+		return x.DefaultCurrency
+	}
+	return ""
+}
+
+func (x *User) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type Picture struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Small         string                 `protobuf:"bytes,1,opt,name=small,proto3" json:"small,omitempty"`
+	Medium        string                 `protobuf:"bytes,2,opt,name=medium,proto3" json:"medium,omitempty"`
+	Large         string                 `protobuf:"bytes,3,opt,name=large,proto3" json:"large,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Picture) Reset() {
+	*x = Picture{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Picture) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Picture) ProtoMessage() {}
+
+func (x *Picture) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Picture.ProtoReflect.Descriptor instead.
+func (*Picture) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Picture) GetSmall() string {
+	if x != nil {
+		return x.Small
+	}
+	return ""
+}
+
+func (x *Picture) GetMedium() string {
+	if x != nil {
+		return x.Medium
+	}
+	return ""
+}
+
+func (x *Picture) GetLarge() string {
+	if x != nil {
+		return x.Large
+	}
+	return ""
+}
+
+// Balance is an amount owed in a single currency. Positive amounts are owed to the
+// user the balance belongs to.
+type Balance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyCode  string                 `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Balance) Reset() {
+	*x = Balance{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Balance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Balance) ProtoMessage() {}
+
+func (x *Balance) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Balance.ProtoReflect.Descriptor instead.
+func (*Balance) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Balance) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *Balance) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type GroupMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Balance       []*Balance             `protobuf:"bytes,2,rep,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupMember) Reset() {
+	*x = GroupMember{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMember) ProtoMessage() {}
+
+func (x *GroupMember) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMember.ProtoReflect.Descriptor instead.
+func (*GroupMember) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GroupMember) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *GroupMember) GetBalance() []*Balance {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+type Debt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          int64                  `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            int64                  `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	CurrencyCode  string                 `protobuf:"bytes,4,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Debt) Reset() {
+	*x = Debt{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Debt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Debt) ProtoMessage() {}
+
+func (x *Debt) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Debt.ProtoReflect.Descriptor instead.
+func (*Debt) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Debt) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *Debt) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *Debt) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Debt) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+type Group struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// One of "apartment", "house", "trip" or "other".
+	GroupType         string                 `protobuf:"bytes,3,opt,name=group_type,json=groupType,proto3" json:"group_type,omitempty"`
+	SimplifyByDefault bool                   `protobuf:"varint,4,opt,name=simplify_by_default,json=simplifyByDefault,proto3" json:"simplify_by_default,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Members           []*GroupMember         `protobuf:"bytes,6,rep,name=members,proto3" json:"members,omitempty"`
+	OriginalDebts     []*Debt                `protobuf:"bytes,7,rep,name=original_debts,json=originalDebts,proto3" json:"original_debts,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Group) Reset() {
+	*x = Group{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Group) ProtoMessage() {}
+
+func (x *Group) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Group.ProtoReflect.Descriptor instead.
+func (*Group) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Group) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Group) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Group) GetGroupType() string {
+	if x != nil {
+		return x.GroupType
+	}
+	return ""
+}
+
+func (x *Group) GetSimplifyByDefault() bool {
+	if x != nil {
+		return x.SimplifyByDefault
+	}
+	return false
+}
+
+func (x *Group) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Group) GetMembers() []*GroupMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Group) GetOriginalDebts() []*Debt {
+	if x != nil {
+		return x.OriginalDebts
+	}
+	return nil
+}
+
+type GroupBalance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Balance       []*Balance             `protobuf:"bytes,2,rep,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupBalance) Reset() {
+	*x = GroupBalance{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupBalance) ProtoMessage() {}
+
+func (x *GroupBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupBalance.ProtoReflect.Descriptor instead.
+func (*GroupBalance) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GroupBalance) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *GroupBalance) GetBalance() []*Balance {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+type Friend struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName      string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Picture       *Picture               `protobuf:"bytes,4,opt,name=picture,proto3" json:"picture,omitempty"`
+	Balance       []*Balance             `protobuf:"bytes,5,rep,name=balance,proto3" json:"balance,omitempty"`
+	Groups        []*GroupBalance        `protobuf:"bytes,6,rep,name=groups,proto3" json:"groups,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Friend) Reset() {
+	*x = Friend{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Friend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Friend) ProtoMessage() {}
+
+func (x *Friend) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Friend.ProtoReflect.Descriptor instead.
+func (*Friend) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Friend) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Friend) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *Friend) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *Friend) GetPicture() *Picture {
+	if x != nil {
+		return x.Picture
+	}
+	return nil
+}
+
+func (x *Friend) GetBalance() []*Balance {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+func (x *Friend) GetGroups() []*GroupBalance {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *Friend) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type Category struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Subcategories []*Category            `protobuf:"bytes,3,rep,name=subcategories,proto3" json:"subcategories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Category) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetSubcategories() []*Category {
+	if x != nil {
+		return x.Subcategories
+	}
+	return nil
+}
+
+type ExpenseShare struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	PaidShare     string                 `protobuf:"bytes,3,opt,name=paid_share,json=paidShare,proto3" json:"paid_share,omitempty"`
+	OwedShare     string                 `protobuf:"bytes,4,opt,name=owed_share,json=owedShare,proto3" json:"owed_share,omitempty"`
+	NetBalance    string                 `protobuf:"bytes,5,opt,name=net_balance,json=netBalance,proto3" json:"net_balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseShare) Reset() {
+	*x = ExpenseShare{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseShare) ProtoMessage() {}
+
+func (x *ExpenseShare) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseShare.ProtoReflect.Descriptor instead.
+func (*ExpenseShare) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ExpenseShare) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ExpenseShare) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *ExpenseShare) GetPaidShare() string {
+	if x != nil {
+		return x.PaidShare
+	}
+	return ""
+}
+
+func (x *ExpenseShare) GetOwedShare() string {
+	if x != nil {
+		return x.OwedShare
+	}
+	return ""
+}
+
+func (x *ExpenseShare) GetNetBalance() string {
+	if x != nil {
+		return x.NetBalance
+	}
+	return ""
+}
+
+type Repayment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          int64                  `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            int64                  `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Repayment) Reset() {
+	*x = Repayment{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Repayment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Repayment) ProtoMessage() {}
+
+func (x *Repayment) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Repayment.ProtoReflect.Descriptor instead.
+func (*Repayment) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Repayment) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *Repayment) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *Repayment) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type Expense struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupId       *int64                 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Details       string                 `protobuf:"bytes,4,opt,name=details,proto3" json:"details,omitempty"`
+	Cost          string                 `protobuf:"bytes,5,opt,name=cost,proto3" json:"cost,omitempty"`
+	CurrencyCode  string                 `protobuf:"bytes,6,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	Payment       bool                   `protobuf:"varint,7,opt,name=payment,proto3" json:"payment,omitempty"`
+	Category      *Category              `protobuf:"bytes,8,opt,name=category,proto3" json:"category,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=date,proto3" json:"date,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Users         []*ExpenseShare        `protobuf:"bytes,13,rep,name=users,proto3" json:"users,omitempty"`
+	Repayments    []*Repayment           `protobuf:"bytes,14,rep,name=repayments,proto3" json:"repayments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Expense) Reset() {
+	*x = Expense{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Expense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Expense) ProtoMessage() {}
+
+func (x *Expense) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Expense.ProtoReflect.Descriptor instead.
+func (*Expense) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Expense) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Expense) GetGroupId() int64 {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return 0
+}
+
+func (x *Expense) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Expense) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *Expense) GetCost() string {
+	if x != nil {
+		return x.Cost
+	}
+	return ""
+}
+
+func (x *Expense) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *Expense) GetPayment() bool {
+	if x != nil {
+		return x.Payment
+	}
+	return false
+}
+
+func (x *Expense) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *Expense) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *Expense) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Expense) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Expense) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Expense) GetUsers() []*ExpenseShare {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *Expense) GetRepayments() []*Repayment {
+	if x != nil {
+		return x.Repayments
+	}
+	return nil
+}
+
+type Comment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	CommentType   string                 `protobuf:"bytes,3,opt,name=comment_type,json=commentType,proto3" json:"comment_type,omitempty"`
+	RelationType  string                 `protobuf:"bytes,4,opt,name=relation_type,json=relationType,proto3" json:"relation_type,omitempty"`
+	RelationId    int64                  `protobuf:"varint,5,opt,name=relation_id,json=relationId,proto3" json:"relation_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	User          *User                  `protobuf:"bytes,8,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Comment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Comment) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Comment) GetCommentType() string {
+	if x != nil {
+		return x.CommentType
+	}
+	return ""
+}
+
+func (x *Comment) GetRelationType() string {
+	if x != nil {
+		return x.RelationType
+	}
+	return ""
+}
+
+func (x *Comment) GetRelationId() int64 {
+	if x != nil {
+		return x.RelationId
+	}
+	return 0
+}
+
+func (x *Comment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// UserRef references an existing user by ID, or a new user by name and email.
+type UserRef struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to User:
+	//
+	//	*UserRef_UserId
+	//	*UserRef_NewUser
+	User          isUserRef_User `protobuf_oneof:"user"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserRef) Reset() {
+	*x = UserRef{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserRef) ProtoMessage() {}
+
+func (x *UserRef) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserRef.ProtoReflect.Descriptor instead.
+func (*UserRef) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UserRef) GetUser() isUserRef_User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UserRef) GetUserId() int64 {
+	if x != nil {
+		if x, ok := x.User.(*UserRef_UserId); ok {
+			return x.UserId
+		}
+	}
+	return 0
+}
+
+func (x *UserRef) GetNewUser() *NewUser {
+	if x != nil {
+		if x, ok := x.User.(*UserRef_NewUser); ok {
+			return x.NewUser
+		}
+	}
+	return nil
+}
+
+type isUserRef_User interface {
+	isUserRef_User()
+}
+
+type UserRef_UserId struct {
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3,oneof"`
+}
+
+type UserRef_NewUser struct {
+	NewUser *NewUser `protobuf:"bytes,2,opt,name=new_user,json=newUser,proto3,oneof"`
+}
+
+func (*UserRef_UserId) isUserRef_User() {}
+
+func (*UserRef_NewUser) isUserRef_User() {}
+
+type NewUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FirstName     string                 `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName      string                 `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewUser) Reset() {
+	*x = NewUser{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewUser) ProtoMessage() {}
+
+func (x *NewUser) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewUser.ProtoReflect.Descriptor instead.
+func (*NewUser) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *NewUser) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *NewUser) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *NewUser) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type GetCurrentUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrentUserRequest) Reset() {
+	*x = GetCurrentUserRequest{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrentUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentUserRequest) ProtoMessage() {}
+
+func (x *GetCurrentUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentUserRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrentUserRequest) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{15}
+}
+
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetUserRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupsRequest) Reset() {
+	*x = ListGroupsRequest{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupsRequest) ProtoMessage() {}
+
+func (x *ListGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{17}
+}
+
+type ListGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*Group               `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupsResponse) Reset() {
+	*x = ListGroupsResponse{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupsResponse) ProtoMessage() {}
+
+func (x *ListGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListGroupsResponse) GetGroups() []*Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type GetGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupRequest) Reset() {
+	*x = GetGroupRequest{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupRequest) ProtoMessage() {}
+
+func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupRequest) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetGroupRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CreateGroupRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Whiteboard        string                 `protobuf:"bytes,2,opt,name=whiteboard,proto3" json:"whiteboard,omitempty"`
+	GroupType         string                 `protobuf:"bytes,3,opt,name=group_type,json=groupType,proto3" json:"group_type,omitempty"`
+	SimplifyByDefault bool                   `protobuf:"varint,4,opt,name=simplify_by_default,json=simplifyByDefault,proto3" json:"simplify_by_default,omitempty"`
+	// The members of the group. If empty, the group is created with just the current
+	// user.
+	Users         []*UserRef `protobuf:"bytes,5,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupRequest) Reset() {
+	*x = CreateGroupRequest{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRequest) ProtoMessage() {}
+
+func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetWhiteboard() string {
+	if x != nil {
+		return x.Whiteboard
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetGroupType() string {
+	if x != nil {
+		return x.GroupType
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetSimplifyByDefault() bool {
+	if x != nil {
+		return x.SimplifyByDefault
+	}
+	return false
+}
+
+func (x *CreateGroupRequest) GetUsers() []*UserRef {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type DeleteGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGroupRequest) Reset() {
+	*x = DeleteGroupRequest{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupRequest) ProtoMessage() {}
+
+func (x *DeleteGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGroupRequest) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteGroupRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGroupResponse) Reset() {
+	*x = DeleteGroupResponse{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupResponse) ProtoMessage() {}
+
+func (x *DeleteGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGroupResponse) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{22}
+}
+
+type UndeleteGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndeleteGroupRequest) Reset() {
+	*x = UndeleteGroupRequest{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndeleteGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteGroupRequest) ProtoMessage() {}
+
+func (x *UndeleteGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteGroupRequest.ProtoReflect.Descriptor instead.
+func (*UndeleteGroupRequest) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UndeleteGroupRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UndeleteGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndeleteGroupResponse) Reset() {
+	*x = UndeleteGroupResponse{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndeleteGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteGroupResponse) ProtoMessage() {}
+
+func (x *UndeleteGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteGroupResponse.ProtoReflect.Descriptor instead.
+func (*UndeleteGroupResponse) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{24}
+}
+
+type AddUserToGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	User          *UserRef               `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddUserToGroupRequest) Reset() {
+	*x = AddUserToGroupRequest{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddUserToGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddUserToGroupRequest) ProtoMessage() {}
+
+func (x *AddUserToGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddUserToGroupRequest.ProtoReflect.Descriptor instead.
+func (*AddUserToGroupRequest) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AddUserToGroupRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *AddUserToGroupRequest) GetUser() *UserRef {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type AddUserToGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddUserToGroupResponse) Reset() {
+	*x = AddUserToGroupResponse{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddUserToGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddUserToGroupResponse) ProtoMessage() {}
+
+func (x *AddUserToGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddUserToGroupResponse.ProtoReflect.Descriptor instead.
+func (*AddUserToGroupResponse) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{26}
+}
+
+type RemoveUserFromGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveUserFromGroupRequest) Reset() {
+	*x = RemoveUserFromGroupRequest{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveUserFromGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveUserFromGroupRequest) ProtoMessage() {}
+
+func (x *RemoveUserFromGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveUserFromGroupRequest.ProtoReflect.Descriptor instead.
+func (*RemoveUserFromGroupRequest) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RemoveUserFromGroupRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *RemoveUserFromGroupRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type RemoveUserFromGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveUserFromGroupResponse) Reset() {
+	*x = RemoveUserFromGroupResponse{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveUserFromGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveUserFromGroupResponse) ProtoMessage() {}
+
+func (x *RemoveUserFromGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveUserFromGroupResponse.ProtoReflect.Descriptor instead.
+func (*RemoveUserFromGroupResponse) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{28}
+}
+
+type ListFriendsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFriendsRequest) Reset() {
+	*x = ListFriendsRequest{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFriendsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFriendsRequest) ProtoMessage() {}
+
+func (x *ListFriendsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFriendsRequest.ProtoReflect.Descriptor instead.
+func (*ListFriendsRequest) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{29}
+}
+
+type ListFriendsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Friends       []*Friend              `protobuf:"bytes,1,rep,name=friends,proto3" json:"friends,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFriendsResponse) Reset() {
+	*x = ListFriendsResponse{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFriendsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFriendsResponse) ProtoMessage() {}
+
+func (x *ListFriendsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFriendsResponse.ProtoReflect.Descriptor instead.
+func (*ListFriendsResponse) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListFriendsResponse) GetFriends() []*Friend {
+	if x != nil {
+		return x.Friends
+	}
+	return nil
+}
+
+type GetFriendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFriendRequest) Reset() {
+	*x = GetFriendRequest{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFriendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendRequest) ProtoMessage() {}
+
+func (x *GetFriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendRequest.ProtoReflect.Descriptor instead.
+func (*GetFriendRequest) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetFriendRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CreateFriendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *NewUser               `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFriendRequest) Reset() {
+	*x = CreateFriendRequest{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFriendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFriendRequest) ProtoMessage() {}
+
+func (x *CreateFriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFriendRequest.ProtoReflect.Descriptor instead.
+func (*CreateFriendRequest) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateFriendRequest) GetUser() *NewUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type DeleteFriendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFriendRequest) Reset() {
+	*x = DeleteFriendRequest{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFriendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFriendRequest) ProtoMessage() {}
+
+func (x *DeleteFriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFriendRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFriendRequest) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteFriendRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteFriendResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFriendResponse) Reset() {
+	*x = DeleteFriendResponse{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFriendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFriendResponse) ProtoMessage() {}
+
+func (x *DeleteFriendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFriendResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFriendResponse) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{34}
+}
+
+type ListExpensesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       *int64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
+	FriendId      *int64                 `protobuf:"varint,2,opt,name=friend_id,json=friendId,proto3,oneof" json:"friend_id,omitempty"`
+	DatedAfter    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=dated_after,json=datedAfter,proto3" json:"dated_after,omitempty"`
+	DatedBefore   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=dated_before,json=datedBefore,proto3" json:"dated_before,omitempty"`
+	UpdatedAfter  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_after,json=updatedAfter,proto3" json:"updated_after,omitempty"`
+	UpdatedBefore *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_before,json=updatedBefore,proto3" json:"updated_before,omitempty"`
+	// The number of expenses fetched from splitwise at a time. Defaults to 100, and
+	// is reduced to limit if that is smaller.
+	PageSize int32 `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The maximum number of expenses to stream, or 0 for all of them.
+	Limit         int32 `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpensesRequest) Reset() {
+	*x = ListExpensesRequest{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpensesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpensesRequest) ProtoMessage() {}
+
+func (x *ListExpensesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpensesRequest.ProtoReflect.Descriptor instead.
+func (*ListExpensesRequest) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListExpensesRequest) GetGroupId() int64 {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return 0
+}
+
+func (x *ListExpensesRequest) GetFriendId() int64 {
+	if x != nil && x.FriendId != nil {
+		return *x.FriendId
+	}
+	return 0
+}
+
+func (x *ListExpensesRequest) GetDatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DatedAfter
+	}
+	return nil
+}
+
+func (x *ListExpensesRequest) GetDatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DatedBefore
+	}
+	return nil
+}
+
+func (x *ListExpensesRequest) GetUpdatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAfter
+	}
+	return nil
+}
+
+func (x *ListExpensesRequest) GetUpdatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedBefore
+	}
+	return nil
+}
+
+func (x *ListExpensesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListExpensesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetExpenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExpenseRequest) Reset() {
+	*x = GetExpenseRequest{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExpenseRequest) ProtoMessage() {}
+
+func (x *GetExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExpenseRequest.ProtoReflect.Descriptor instead.
+func (*GetExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetExpenseRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type Share struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *UserRef               `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	PaidShare     string                 `protobuf:"bytes,2,opt,name=paid_share,json=paidShare,proto3" json:"paid_share,omitempty"`
+	OwedShare     string                 `protobuf:"bytes,3,opt,name=owed_share,json=owedShare,proto3" json:"owed_share,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Share) Reset() {
+	*x = Share{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Share) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Share) ProtoMessage() {}
+
+func (x *Share) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Share.ProtoReflect.Descriptor instead.
+func (*Share) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Share) GetUser() *UserRef {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Share) GetPaidShare() string {
+	if x != nil {
+		return x.PaidShare
+	}
+	return ""
+}
+
+func (x *Share) GetOwedShare() string {
+	if x != nil {
+		return x.OwedShare
+	}
+	return ""
+}
+
+type CreateExpenseRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Cost        string                 `protobuf:"bytes,1,opt,name=cost,proto3" json:"cost,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Payment     bool                   `protobuf:"varint,3,opt,name=payment,proto3" json:"payment,omitempty"`
+	// The group of the expense. If shares are not given, the expense is split equally
+	// within the group and paid for by the current user.
+	GroupId      *int64                 `protobuf:"varint,4,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
+	Shares       []*Share               `protobuf:"bytes,5,rep,name=shares,proto3" json:"shares,omitempty"`
+	Details      *string                `protobuf:"bytes,6,opt,name=details,proto3,oneof" json:"details,omitempty"`
+	Date         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=date,proto3" json:"date,omitempty"`
+	CurrencyCode *string                `protobuf:"bytes,8,opt,name=currency_code,json=currencyCode,proto3,oneof" json:"currency_code,omitempty"`
+	CategoryId   *int64                 `protobuf:"varint,9,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	// Makes creation safe to retry, see CreateExpense in the Go client.
+	IdempotencyKey string `protobuf:"bytes,10,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateExpenseRequest) Reset() {
+	*x = CreateExpenseRequest{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExpenseRequest) ProtoMessage() {}
+
+func (x *CreateExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExpenseRequest.ProtoReflect.Descriptor instead.
+func (*CreateExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateExpenseRequest) GetCost() string {
+	if x != nil {
+		return x.Cost
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetPayment() bool {
+	if x != nil {
+		return x.Payment
+	}
+	return false
+}
+
+func (x *CreateExpenseRequest) GetGroupId() int64 {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return 0
+}
+
+func (x *CreateExpenseRequest) GetShares() []*Share {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+func (x *CreateExpenseRequest) GetDetails() string {
+	if x != nil && x.Details != nil {
+		return *x.Details
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *CreateExpenseRequest) GetCurrencyCode() string {
+	if x != nil && x.CurrencyCode != nil {
+		return *x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *CreateExpenseRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type DeleteExpenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExpenseRequest) Reset() {
+	*x = DeleteExpenseRequest{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExpenseRequest) ProtoMessage() {}
+
+func (x *DeleteExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExpenseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DeleteExpenseRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteExpenseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExpenseResponse) Reset() {
+	*x = DeleteExpenseResponse{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExpenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExpenseResponse) ProtoMessage() {}
+
+func (x *DeleteExpenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExpenseResponse.ProtoReflect.Descriptor instead.
+func (*DeleteExpenseResponse) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{40}
+}
+
+type UndeleteExpenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndeleteExpenseRequest) Reset() {
+	*x = UndeleteExpenseRequest{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndeleteExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteExpenseRequest) ProtoMessage() {}
+
+func (x *UndeleteExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteExpenseRequest.ProtoReflect.Descriptor instead.
+func (*UndeleteExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UndeleteExpenseRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UndeleteExpenseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndeleteExpenseResponse) Reset() {
+	*x = UndeleteExpenseResponse{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndeleteExpenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteExpenseResponse) ProtoMessage() {}
+
+func (x *UndeleteExpenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteExpenseResponse.ProtoReflect.Descriptor instead.
+func (*UndeleteExpenseResponse) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{42}
+}
+
+type ListCommentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExpenseId     int64                  `protobuf:"varint,1,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsRequest) Reset() {
+	*x = ListCommentsRequest{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsRequest) ProtoMessage() {}
+
+func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListCommentsRequest) GetExpenseId() int64 {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return 0
+}
+
+type ListCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsResponse) Reset() {
+	*x = ListCommentsResponse{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsResponse) ProtoMessage() {}
+
+func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListCommentsResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+type CreateCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExpenseId     int64                  `protobuf:"varint,1,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCommentRequest) Reset() {
+	*x = CreateCommentRequest{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentRequest) ProtoMessage() {}
+
+func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreateCommentRequest) GetExpenseId() int64 {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return 0
+}
+
+func (x *CreateCommentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type DeleteCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DeleteCommentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{47}
+}
+
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_splitwise_v1_splitwise_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_splitwise_v1_splitwise_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+var File_splitwise_v1_splitwise_proto protoreflect.FileDescriptor
+
+const file_splitwise_v1_splitwise_proto_rawDesc = "" +
+	"\n" +
+	"\x1csplitwise/v1/splitwise.proto\x12\fsplitwise.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8d\x02\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x02 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x03 \x01(\tR\blastName\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12/\n" +
+	"\x13registration_status\x18\x05 \x01(\tR\x12registrationStatus\x12/\n" +
+	"\apicture\x18\x06 \x01(\v2\x15.splitwise.v1.PictureR\apicture\x12)\n" +
+	"\x10default_currency\x18\a \x01(\tR\x0fdefaultCurrency\x12\x16\n" +
+	"\x06locale\x18\b \x01(\tR\x06locale\"M\n" +
+	"\aPicture\x12\x14\n" +
+	"\x05small\x18\x01 \x01(\tR\x05small\x12\x16\n" +
+	"\x06medium\x18\x02 \x01(\tR\x06medium\x12\x14\n" +
+	"\x05large\x18\x03 \x01(\tR\x05large\"F\n" +
+	"\aBalance\x12#\n" +
+	"\rcurrency_code\x18\x01 \x01(\tR\fcurrencyCode\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\"f\n" +
+	"\vGroupMember\x12&\n" +
+	"\x04user\x18\x01 \x01(\v2\x12.splitwise.v1.UserR\x04user\x12/\n" +
+	"\abalance\x18\x02 \x03(\v2\x15.splitwise.v1.BalanceR\abalance\"g\n" +
+	"\x04Debt\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\x03R\x02to\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12#\n" +
+	"\rcurrency_code\x18\x04 \x01(\tR\fcurrencyCode\"\xa5\x02\n" +
+	"\x05Group\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"group_type\x18\x03 \x01(\tR\tgroupType\x12.\n" +
+	"\x13simplify_by_default\x18\x04 \x01(\bR\x11simplifyByDefault\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x123\n" +
+	"\amembers\x18\x06 \x03(\v2\x19.splitwise.v1.GroupMemberR\amembers\x129\n" +
+	"\x0eoriginal_debts\x18\a \x03(\v2\x12.splitwise.v1.DebtR\roriginalDebts\"Z\n" +
+	"\fGroupBalance\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12/\n" +
+	"\abalance\x18\x02 \x03(\v2\x15.splitwise.v1.BalanceR\abalance\"\xa5\x02\n" +
+	"\x06Friend\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x02 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x03 \x01(\tR\blastName\x12/\n" +
+	"\apicture\x18\x04 \x01(\v2\x15.splitwise.v1.PictureR\apicture\x12/\n" +
+	"\abalance\x18\x05 \x03(\v2\x15.splitwise.v1.BalanceR\abalance\x122\n" +
+	"\x06groups\x18\x06 \x03(\v2\x1a.splitwise.v1.GroupBalanceR\x06groups\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"l\n" +
+	"\bCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12<\n" +
+	"\rsubcategories\x18\x03 \x03(\v2\x16.splitwise.v1.CategoryR\rsubcategories\"\xae\x01\n" +
+	"\fExpenseShare\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12&\n" +
+	"\x04user\x18\x02 \x01(\v2\x12.splitwise.v1.UserR\x04user\x12\x1d\n" +
+	"\n" +
+	"paid_share\x18\x03 \x01(\tR\tpaidShare\x12\x1d\n" +
+	"\n" +
+	"owed_share\x18\x04 \x01(\tR\towedShare\x12\x1f\n" +
+	"\vnet_balance\x18\x05 \x01(\tR\n" +
+	"netBalance\"G\n" +
+	"\tRepayment\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\x03R\x02to\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\"\xd5\x04\n" +
+	"\aExpense\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1e\n" +
+	"\bgroup_id\x18\x02 \x01(\x03H\x00R\agroupId\x88\x01\x01\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
+	"\adetails\x18\x04 \x01(\tR\adetails\x12\x12\n" +
+	"\x04cost\x18\x05 \x01(\tR\x04cost\x12#\n" +
+	"\rcurrency_code\x18\x06 \x01(\tR\fcurrencyCode\x12\x18\n" +
+	"\apayment\x18\a \x01(\bR\apayment\x122\n" +
+	"\bcategory\x18\b \x01(\v2\x16.splitwise.v1.CategoryR\bcategory\x12.\n" +
+	"\x04date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
+	"\n" +
+	"deleted_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x120\n" +
+	"\x05users\x18\r \x03(\v2\x1a.splitwise.v1.ExpenseShareR\x05users\x127\n" +
+	"\n" +
+	"repayments\x18\x0e \x03(\v2\x17.splitwise.v1.RepaymentR\n" +
+	"repaymentsB\v\n" +
+	"\t_group_id\"\xba\x02\n" +
+	"\aComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12!\n" +
+	"\fcomment_type\x18\x03 \x01(\tR\vcommentType\x12#\n" +
+	"\rrelation_type\x18\x04 \x01(\tR\frelationType\x12\x1f\n" +
+	"\vrelation_id\x18\x05 \x01(\x03R\n" +
+	"relationId\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"deleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12&\n" +
+	"\x04user\x18\b \x01(\v2\x12.splitwise.v1.UserR\x04user\"`\n" +
+	"\aUserRef\x12\x19\n" +
+	"\auser_id\x18\x01 \x01(\x03H\x00R\x06userId\x122\n" +
+	"\bnew_user\x18\x02 \x01(\v2\x15.splitwise.v1.NewUserH\x00R\anewUserB\x06\n" +
+	"\x04user\"[\n" +
+	"\aNewUser\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x01 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x02 \x01(\tR\blastName\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\"\x17\n" +
+	"\x15GetCurrentUserRequest\" \n" +
+	"\x0eGetUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x13\n" +
+	"\x11ListGroupsRequest\"A\n" +
+	"\x12ListGroupsResponse\x12+\n" +
+	"\x06groups\x18\x01 \x03(\v2\x13.splitwise.v1.GroupR\x06groups\"!\n" +
+	"\x0fGetGroupRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xc4\x01\n" +
+	"\x12CreateGroupRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"whiteboard\x18\x02 \x01(\tR\n" +
+	"whiteboard\x12\x1d\n" +
+	"\n" +
+	"group_type\x18\x03 \x01(\tR\tgroupType\x12.\n" +
+	"\x13simplify_by_default\x18\x04 \x01(\bR\x11simplifyByDefault\x12+\n" +
+	"\x05users\x18\x05 \x03(\v2\x15.splitwise.v1.UserRefR\x05users\"$\n" +
+	"\x12DeleteGroupRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x15\n" +
+	"\x13DeleteGroupResponse\"&\n" +
+	"\x14UndeleteGroupRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x17\n" +
+	"\x15UndeleteGroupResponse\"]\n" +
+	"\x15AddUserToGroupRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12)\n" +
+	"\x04user\x18\x02 \x01(\v2\x15.splitwise.v1.UserRefR\x04user\"\x18\n" +
+	"\x16AddUserToGroupResponse\"P\n" +
+	"\x1aRemoveUserFromGroupRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"\x1d\n" +
+	"\x1bRemoveUserFromGroupResponse\"\x14\n" +
+	"\x12ListFriendsRequest\"E\n" +
+	"\x13ListFriendsResponse\x12.\n" +
+	"\afriends\x18\x01 \x03(\v2\x14.splitwise.v1.FriendR\afriends\"\"\n" +
+	"\x10GetFriendRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"@\n" +
+	"\x13CreateFriendRequest\x12)\n" +
+	"\x04user\x18\x01 \x01(\v2\x15.splitwise.v1.NewUserR\x04user\"%\n" +
+	"\x13DeleteFriendRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x16\n" +
+	"\x14DeleteFriendResponse\"\xa5\x03\n" +
+	"\x13ListExpensesRequest\x12\x1e\n" +
+	"\bgroup_id\x18\x01 \x01(\x03H\x00R\agroupId\x88\x01\x01\x12 \n" +
+	"\tfriend_id\x18\x02 \x01(\x03H\x01R\bfriendId\x88\x01\x01\x12;\n" +
+	"\vdated_after\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"datedAfter\x12=\n" +
+	"\fdated_before\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vdatedBefore\x12?\n" +
+	"\rupdated_after\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\fupdatedAfter\x12A\n" +
+	"\x0eupdated_before\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rupdatedBefore\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\x12\x14\n" +
+	"\x05limit\x18\b \x01(\x05R\x05limitB\v\n" +
+	"\t_group_idB\f\n" +
+	"\n" +
+	"_friend_id\"#\n" +
+	"\x11GetExpenseRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"p\n" +
+	"\x05Share\x12)\n" +
+	"\x04user\x18\x01 \x01(\v2\x15.splitwise.v1.UserRefR\x04user\x12\x1d\n" +
+	"\n" +
+	"paid_share\x18\x02 \x01(\tR\tpaidShare\x12\x1d\n" +
+	"\n" +
+	"owed_share\x18\x03 \x01(\tR\towedShare\"\xb6\x03\n" +
+	"\x14CreateExpenseRequest\x12\x12\n" +
+	"\x04cost\x18\x01 \x01(\tR\x04cost\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x18\n" +
+	"\apayment\x18\x03 \x01(\bR\apayment\x12\x1e\n" +
+	"\bgroup_id\x18\x04 \x01(\x03H\x00R\agroupId\x88\x01\x01\x12+\n" +
+	"\x06shares\x18\x05 \x03(\v2\x13.splitwise.v1.ShareR\x06shares\x12\x1d\n" +
+	"\adetails\x18\x06 \x01(\tH\x01R\adetails\x88\x01\x01\x12.\n" +
+	"\x04date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12(\n" +
+	"\rcurrency_code\x18\b \x01(\tH\x02R\fcurrencyCode\x88\x01\x01\x12$\n" +
+	"\vcategory_id\x18\t \x01(\x03H\x03R\n" +
+	"categoryId\x88\x01\x01\x12'\n" +
+	"\x0fidempotency_key\x18\n" +
+	" \x01(\tR\x0eidempotencyKeyB\v\n" +
+	"\t_group_idB\n" +
+	"\n" +
+	"\b_detailsB\x10\n" +
+	"\x0e_currency_codeB\x0e\n" +
+	"\f_category_id\"&\n" +
+	"\x14DeleteExpenseRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x17\n" +
+	"\x15DeleteExpenseResponse\"(\n" +
+	"\x16UndeleteExpenseRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x19\n" +
+	"\x17UndeleteExpenseResponse\"4\n" +
+	"\x13ListCommentsRequest\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x01 \x01(\x03R\texpenseId\"I\n" +
+	"\x14ListCommentsResponse\x121\n" +
+	"\bcomments\x18\x01 \x03(\v2\x15.splitwise.v1.CommentR\bcomments\"O\n" +
+	"\x14CreateCommentRequest\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x01 \x01(\x03R\texpenseId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"&\n" +
+	"\x14DeleteCommentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x17\n" +
+	"\x15ListCategoriesRequest\"P\n" +
+	"\x16ListCategoriesResponse\x126\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x16.splitwise.v1.CategoryR\n" +
+	"categories2\xfc\r\n" +
+	"\tSplitwise\x12I\n" +
+	"\x0eGetCurrentUser\x12#.splitwise.v1.GetCurrentUserRequest\x1a\x12.splitwise.v1.User\x12;\n" +
+	"\aGetUser\x12\x1c.splitwise.v1.GetUserRequest\x1a\x12.splitwise.v1.User\x12O\n" +
+	"\n" +
+	"ListGroups\x12\x1f.splitwise.v1.ListGroupsRequest\x1a .splitwise.v1.ListGroupsResponse\x12>\n" +
+	"\bGetGroup\x12\x1d.splitwise.v1.GetGroupRequest\x1a\x13.splitwise.v1.Group\x12D\n" +
+	"\vCreateGroup\x12 .splitwise.v1.CreateGroupRequest\x1a\x13.splitwise.v1.Group\x12R\n" +
+	"\vDeleteGroup\x12 .splitwise.v1.DeleteGroupRequest\x1a!.splitwise.v1.DeleteGroupResponse\x12X\n" +
+	"\rUndeleteGroup\x12\".splitwise.v1.UndeleteGroupRequest\x1a#.splitwise.v1.UndeleteGroupResponse\x12[\n" +
+	"\x0eAddUserToGroup\x12#.splitwise.v1.AddUserToGroupRequest\x1a$.splitwise.v1.AddUserToGroupResponse\x12j\n" +
+	"\x13RemoveUserFromGroup\x12(.splitwise.v1.RemoveUserFromGroupRequest\x1a).splitwise.v1.RemoveUserFromGroupResponse\x12R\n" +
+	"\vListFriends\x12 .splitwise.v1.ListFriendsRequest\x1a!.splitwise.v1.ListFriendsResponse\x12A\n" +
+	"\tGetFriend\x12\x1e.splitwise.v1.GetFriendRequest\x1a\x14.splitwise.v1.Friend\x12G\n" +
+	"\fCreateFriend\x12!.splitwise.v1.CreateFriendRequest\x1a\x14.splitwise.v1.Friend\x12U\n" +
+	"\fDeleteFriend\x12!.splitwise.v1.DeleteFriendRequest\x1a\".splitwise.v1.DeleteFriendResponse\x12J\n" +
+	"\fListExpenses\x12!.splitwise.v1.ListExpensesRequest\x1a\x15.splitwise.v1.Expense0\x01\x12D\n" +
+	"\n" +
+	"GetExpense\x12\x1f.splitwise.v1.GetExpenseRequest\x1a\x15.splitwise.v1.Expense\x12J\n" +
+	"\rCreateExpense\x12\".splitwise.v1.CreateExpenseRequest\x1a\x15.splitwise.v1.Expense\x12X\n" +
+	"\rDeleteExpense\x12\".splitwise.v1.DeleteExpenseRequest\x1a#.splitwise.v1.DeleteExpenseResponse\x12^\n" +
+	"\x0fUndeleteExpense\x12$.splitwise.v1.UndeleteExpenseRequest\x1a%.splitwise.v1.UndeleteExpenseResponse\x12U\n" +
+	"\fListComments\x12!.splitwise.v1.ListCommentsRequest\x1a\".splitwise.v1.ListCommentsResponse\x12J\n" +
+	"\rCreateComment\x12\".splitwise.v1.CreateCommentRequest\x1a\x15.splitwise.v1.Comment\x12J\n" +
+	"\rDeleteComment\x12\".splitwise.v1.DeleteCommentRequest\x1a\x15.splitwise.v1.Comment\x12[\n" +
+	"\x0eListCategories\x12#.splitwise.v1.ListCategoriesRequest\x1a$.splitwise.v1.ListCategoriesResponseB=Z;github.com/cwbriones/go-splitwise/grpcsplitwise/splitwisepbb\x06proto3"
+
+var (
+	file_splitwise_v1_splitwise_proto_rawDescOnce sync.Once
+	file_splitwise_v1_splitwise_proto_rawDescData []byte
+)
+
+func file_splitwise_v1_splitwise_proto_rawDescGZIP() []byte {
+	file_splitwise_v1_splitwise_proto_rawDescOnce.Do(func() {
+		file_splitwise_v1_splitwise_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_splitwise_v1_splitwise_proto_rawDesc), len(file_splitwise_v1_splitwise_proto_rawDesc)))
+	})
+	return file_splitwise_v1_splitwise_proto_rawDescData
+}
+
+var file_splitwise_v1_splitwise_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
+var file_splitwise_v1_splitwise_proto_goTypes = []any{
+	(*User)(nil),                        // 0: splitwise.v1.User
+	(*Picture)(nil),                     // 1: splitwise.v1.Picture
+	(*Balance)(nil),                     // 2: splitwise.v1.Balance
+	(*GroupMember)(nil),                 // 3: splitwise.v1.GroupMember
+	(*Debt)(nil),                        // 4: splitwise.v1.Debt
+	(*Group)(nil),                       // 5: splitwise.v1.Group
+	(*GroupBalance)(nil),                // 6: splitwise.v1.GroupBalance
+	(*Friend)(nil),                      // 7: splitwise.v1.Friend
+	(*Category)(nil),                    // 8: splitwise.v1.Category
+	(*ExpenseShare)(nil),                // 9: splitwise.v1.ExpenseShare
+	(*Repayment)(nil),                   // 10: splitwise.v1.Repayment
+	(*Expense)(nil),                     // 11: splitwise.v1.Expense
+	(*Comment)(nil),                     // 12: splitwise.v1.Comment
+	(*UserRef)(nil),                     // 13: splitwise.v1.UserRef
+	(*NewUser)(nil),                     // 14: splitwise.v1.NewUser
+	(*GetCurrentUserRequest)(nil),       // 15: splitwise.v1.GetCurrentUserRequest
+	(*GetUserRequest)(nil),              // 16: splitwise.v1.GetUserRequest
+	(*ListGroupsRequest)(nil),           // 17: splitwise.v1.ListGroupsRequest
+	(*ListGroupsResponse)(nil),          // 18: splitwise.v1.ListGroupsResponse
+	(*GetGroupRequest)(nil),             // 19: splitwise.v1.GetGroupRequest
+	(*CreateGroupRequest)(nil),          // 20: splitwise.v1.CreateGroupRequest
+	(*DeleteGroupRequest)(nil),          // 21: splitwise.v1.DeleteGroupRequest
+	(*DeleteGroupResponse)(nil),         // 22: splitwise.v1.DeleteGroupResponse
+	(*UndeleteGroupRequest)(nil),        // 23: splitwise.v1.UndeleteGroupRequest
+	(*UndeleteGroupResponse)(nil),       // 24: splitwise.v1.UndeleteGroupResponse
+	(*AddUserToGroupRequest)(nil),       // 25: splitwise.v1.AddUserToGroupRequest
+	(*AddUserToGroupResponse)(nil),      // 26: splitwise.v1.AddUserToGroupResponse
+	(*RemoveUserFromGroupRequest)(nil),  // 27: splitwise.v1.RemoveUserFromGroupRequest
+	(*RemoveUserFromGroupResponse)(nil), // 28: splitwise.v1.RemoveUserFromGroupResponse
+	(*ListFriendsRequest)(nil),          // 29: splitwise.v1.ListFriendsRequest
+	(*ListFriendsResponse)(nil),         // 30: splitwise.v1.ListFriendsResponse
+	(*GetFriendRequest)(nil),            // 31: splitwise.v1.GetFriendRequest
+	(*CreateFriendRequest)(nil),         // 32: splitwise.v1.CreateFriendRequest
+	(*DeleteFriendRequest)(nil),         // 33: splitwise.v1.DeleteFriendRequest
+	(*DeleteFriendResponse)(nil),        // 34: splitwise.v1.DeleteFriendResponse
+	(*ListExpensesRequest)(nil),         // 35: splitwise.v1.ListExpensesRequest
+	(*GetExpenseRequest)(nil),           // 36: splitwise.v1.GetExpenseRequest
+	(*Share)(nil),                       // 37: splitwise.v1.Share
+	(*CreateExpenseRequest)(nil),        // 38: splitwise.v1.CreateExpenseRequest
+	(*DeleteExpenseRequest)(nil),        // 39: splitwise.v1.DeleteExpenseRequest
+	(*DeleteExpenseResponse)(nil),       // 40: splitwise.v1.DeleteExpenseResponse
+	(*UndeleteExpenseRequest)(nil),      // 41: splitwise.v1.UndeleteExpenseRequest
+	(*UndeleteExpenseResponse)(nil),     // 42: splitwise.v1.UndeleteExpenseResponse
+	(*ListCommentsRequest)(nil),         // 43: splitwise.v1.ListCommentsRequest
+	(*ListCommentsResponse)(nil),        // 44: splitwise.v1.ListCommentsResponse
+	(*CreateCommentRequest)(nil),        // 45: splitwise.v1.CreateCommentRequest
+	(*DeleteCommentRequest)(nil),        // 46: splitwise.v1.DeleteCommentRequest
+	(*ListCategoriesRequest)(nil),       // 47: splitwise.v1.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),      // 48: splitwise.v1.ListCategoriesResponse
+	(*timestamppb.Timestamp)(nil),       // 49: google.protobuf.Timestamp
+}
+var file_splitwise_v1_splitwise_proto_depIdxs = []int32{
+	1,  // 0: splitwise.v1.User.picture:type_name -> splitwise.v1.Picture
+	0,  // 1: splitwise.v1.GroupMember.user:type_name -> splitwise.v1.User
+	2,  // 2: splitwise.v1.GroupMember.balance:type_name -> splitwise.v1.Balance
+	49, // 3: splitwise.v1.Group.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 4: splitwise.v1.Group.members:type_name -> splitwise.v1.GroupMember
+	4,  // 5: splitwise.v1.Group.original_debts:type_name -> splitwise.v1.Debt
+	2,  // 6: splitwise.v1.GroupBalance.balance:type_name -> splitwise.v1.Balance
+	1,  // 7: splitwise.v1.Friend.picture:type_name -> splitwise.v1.Picture
+	2,  // 8: splitwise.v1.Friend.balance:type_name -> splitwise.v1.Balance
+	6,  // 9: splitwise.v1.Friend.groups:type_name -> splitwise.v1.GroupBalance
+	49, // 10: splitwise.v1.Friend.updated_at:type_name -> google.protobuf.Timestamp
+	8,  // 11: splitwise.v1.Category.subcategories:type_name -> splitwise.v1.Category
+	0,  // 12: splitwise.v1.ExpenseShare.user:type_name -> splitwise.v1.User
+	8,  // 13: splitwise.v1.Expense.category:type_name -> splitwise.v1.Category
+	49, // 14: splitwise.v1.Expense.date:type_name -> google.protobuf.Timestamp
+	49, // 15: splitwise.v1.Expense.created_at:type_name -> google.protobuf.Timestamp
+	49, // 16: splitwise.v1.Expense.updated_at:type_name -> google.protobuf.Timestamp
+	49, // 17: splitwise.v1.Expense.deleted_at:type_name -> google.protobuf.Timestamp
+	9,  // 18: splitwise.v1.Expense.users:type_name -> splitwise.v1.ExpenseShare
+	10, // 19: splitwise.v1.Expense.repayments:type_name -> splitwise.v1.Repayment
+	49, // 20: splitwise.v1.Comment.created_at:type_name -> google.protobuf.Timestamp
+	49, // 21: splitwise.v1.Comment.deleted_at:type_name -> google.protobuf.Timestamp
+	0,  // 22: splitwise.v1.Comment.user:type_name -> splitwise.v1.User
+	14, // 23: splitwise.v1.UserRef.new_user:type_name -> splitwise.v1.NewUser
+	5,  // 24: splitwise.v1.ListGroupsResponse.groups:type_name -> splitwise.v1.Group
+	13, // 25: splitwise.v1.CreateGroupRequest.users:type_name -> splitwise.v1.UserRef
+	13, // 26: splitwise.v1.AddUserToGroupRequest.user:type_name -> splitwise.v1.UserRef
+	7,  // 27: splitwise.v1.ListFriendsResponse.friends:type_name -> splitwise.v1.Friend
+	14, // 28: splitwise.v1.CreateFriendRequest.user:type_name -> splitwise.v1.NewUser
+	49, // 29: splitwise.v1.ListExpensesRequest.dated_after:type_name -> google.protobuf.Timestamp
+	49, // 30: splitwise.v1.ListExpensesRequest.dated_before:type_name -> google.protobuf.Timestamp
+	49, // 31: splitwise.v1.ListExpensesRequest.updated_after:type_name -> google.protobuf.Timestamp
+	49, // 32: splitwise.v1.ListExpensesRequest.updated_before:type_name -> google.protobuf.Timestamp
+	13, // 33: splitwise.v1.Share.user:type_name -> splitwise.v1.UserRef
+	37, // 34: splitwise.v1.CreateExpenseRequest.shares:type_name -> splitwise.v1.Share
+	49, // 35: splitwise.v1.CreateExpenseRequest.date:type_name -> google.protobuf.Timestamp
+	12, // 36: splitwise.v1.ListCommentsResponse.comments:type_name -> splitwise.v1.Comment
+	8,  // 37: splitwise.v1.ListCategoriesResponse.categories:type_name -> splitwise.v1.Category
+	15, // 38: splitwise.v1.Splitwise.GetCurrentUser:input_type -> splitwise.v1.GetCurrentUserRequest
+	16, // 39: splitwise.v1.Splitwise.GetUser:input_type -> splitwise.v1.GetUserRequest
+	17, // 40: splitwise.v1.Splitwise.ListGroups:input_type -> splitwise.v1.ListGroupsRequest
+	19, // 41: splitwise.v1.Splitwise.GetGroup:input_type -> splitwise.v1.GetGroupRequest
+	20, // 42: splitwise.v1.Splitwise.CreateGroup:input_type -> splitwise.v1.CreateGroupRequest
+	21, // 43: splitwise.v1.Splitwise.DeleteGroup:input_type -> splitwise.v1.DeleteGroupRequest
+	23, // 44: splitwise.v1.Splitwise.UndeleteGroup:input_type -> splitwise.v1.UndeleteGroupRequest
+	25, // 45: splitwise.v1.Splitwise.AddUserToGroup:input_type -> splitwise.v1.AddUserToGroupRequest
+	27, // 46: splitwise.v1.Splitwise.RemoveUserFromGroup:input_type -> splitwise.v1.RemoveUserFromGroupRequest
+	29, // 47: splitwise.v1.Splitwise.ListFriends:input_type -> splitwise.v1.ListFriendsRequest
+	31, // 48: splitwise.v1.Splitwise.GetFriend:input_type -> splitwise.v1.GetFriendRequest
+	32, // 49: splitwise.v1.Splitwise.CreateFriend:input_type -> splitwise.v1.CreateFriendRequest
+	33, // 50: splitwise.v1.Splitwise.DeleteFriend:input_type -> splitwise.v1.DeleteFriendRequest
+	35, // 51: splitwise.v1.Splitwise.ListExpenses:input_type -> splitwise.v1.ListExpensesRequest
+	36, // 52: splitwise.v1.Splitwise.GetExpense:input_type -> splitwise.v1.GetExpenseRequest
+	38, // 53: splitwise.v1.Splitwise.CreateExpense:input_type -> splitwise.v1.CreateExpenseRequest
+	39, // 54: splitwise.v1.Splitwise.DeleteExpense:input_type -> splitwise.v1.DeleteExpenseRequest
+	41, // 55: splitwise.v1.Splitwise.UndeleteExpense:input_type -> splitwise.v1.UndeleteExpenseRequest
+	43, // 56: splitwise.v1.Splitwise.ListComments:input_type -> splitwise.v1.ListCommentsRequest
+	45, // 57: splitwise.v1.Splitwise.CreateComment:input_type -> splitwise.v1.CreateCommentRequest
+	46, // 58: splitwise.v1.Splitwise.DeleteComment:input_type -> splitwise.v1.DeleteCommentRequest
+	47, // 59: splitwise.v1.Splitwise.ListCategories:input_type -> splitwise.v1.ListCategoriesRequest
+	0,  // 60: splitwise.v1.Splitwise.GetCurrentUser:output_type -> splitwise.v1.User
+	0,  // 61: splitwise.v1.Splitwise.GetUser:output_type -> splitwise.v1.User
+	18, // 62: splitwise.v1.Splitwise.ListGroups:output_type -> splitwise.v1.ListGroupsResponse
+	5,  // 63: splitwise.v1.Splitwise.GetGroup:output_type -> splitwise.v1.Group
+	5,  // 64: splitwise.v1.Splitwise.CreateGroup:output_type -> splitwise.v1.Group
+	22, // 65: splitwise.v1.Splitwise.DeleteGroup:output_type -> splitwise.v1.DeleteGroupResponse
+	24, // 66: splitwise.v1.Splitwise.UndeleteGroup:output_type -> splitwise.v1.UndeleteGroupResponse
+	26, // 67: splitwise.v1.Splitwise.AddUserToGroup:output_type -> splitwise.v1.AddUserToGroupResponse
+	28, // 68: splitwise.v1.Splitwise.RemoveUserFromGroup:output_type -> splitwise.v1.RemoveUserFromGroupResponse
+	30, // 69: splitwise.v1.Splitwise.ListFriends:output_type -> splitwise.v1.ListFriendsResponse
+	7,  // 70: splitwise.v1.Splitwise.GetFriend:output_type -> splitwise.v1.Friend
+	7,  // 71: splitwise.v1.Splitwise.CreateFriend:output_type -> splitwise.v1.Friend
+	34, // 72: splitwise.v1.Splitwise.DeleteFriend:output_type -> splitwise.v1.DeleteFriendResponse
+	11, // 73: splitwise.v1.Splitwise.ListExpenses:output_type -> splitwise.v1.Expense
+	11, // 74: splitwise.v1.Splitwise.GetExpense:output_type -> splitwise.v1.Expense
+	11, // 75: splitwise.v1.Splitwise.CreateExpense:output_type -> splitwise.v1.Expense
+	40, // 76: splitwise.v1.Splitwise.DeleteExpense:output_type -> splitwise.v1.DeleteExpenseResponse
+	42, // 77: splitwise.v1.Splitwise.UndeleteExpense:output_type -> splitwise.v1.UndeleteExpenseResponse
+	44, // 78: splitwise.v1.Splitwise.ListComments:output_type -> splitwise.v1.ListCommentsResponse
+	12, // 79: splitwise.v1.Splitwise.CreateComment:output_type -> splitwise.v1.Comment
+	12, // 80: splitwise.v1.Splitwise.DeleteComment:output_type -> splitwise.v1.Comment
+	48, // 81: splitwise.v1.Splitwise.ListCategories:output_type -> splitwise.v1.ListCategoriesResponse
+	60, // [60:82] is the sub-list for method output_type
+	38, // [38:60] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
+}
+
+func init() { file_splitwise_v1_splitwise_proto_init() }
+func file_splitwise_v1_splitwise_proto_init() {
+	if File_splitwise_v1_splitwise_proto != nil {
+		return
+	}
+	file_splitwise_v1_splitwise_proto_msgTypes[11].OneofWrappers = []any{}
+	file_splitwise_v1_splitwise_proto_msgTypes[13].OneofWrappers = []any{
+		(*UserRef_UserId)(nil),
+		(*UserRef_NewUser)(nil),
+	}
+	file_splitwise_v1_splitwise_proto_msgTypes[35].OneofWrappers = []any{}
+	file_splitwise_v1_splitwise_proto_msgTypes[38].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_splitwise_v1_splitwise_proto_rawDesc), len(file_splitwise_v1_splitwise_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   49,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_splitwise_v1_splitwise_proto_goTypes,
+		DependencyIndexes: file_splitwise_v1_splitwise_proto_depIdxs,
+		MessageInfos:      file_splitwise_v1_splitwise_proto_msgTypes,
+	}.Build()
+	File_splitwise_v1_splitwise_proto = out.File
+	file_splitwise_v1_splitwise_proto_goTypes = nil
+	file_splitwise_v1_splitwise_proto_depIdxs = nil
+}
@@ -0,0 +1,89 @@
+package splitwise
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	idempotencyMarker = "idempotency-key:"
+
+	// maxIdempotencyKeyLen is the maximum length of an idempotency key.
+	maxIdempotencyKeyLen = 64
+
+	// idempotencyWindow is how far back to search for an expense with a matching key.
+	idempotencyWindow = 24 * time.Hour
+	idempotencyPage   = 100
+)
+
+// ErrInvalidIdempotencyKey is returned by CreateExpense if
+// CreateExpenseRequest.IdempotencyKey is invalid.
+var ErrInvalidIdempotencyKey = errors.New("invalid idempotency key")
+
+// checkIdempotencyKey returns an error if key cannot be recorded in the details of an
+// expense and read back by Expense.IdempotencyKey. Keys may only contain ASCII letters,
+// digits, '.', '_' and '-', and be at most 64 bytes long.
+func checkIdempotencyKey(key string) error {
+	if len(key) > maxIdempotencyKeyLen {
+		return fmt.Errorf("%w: longer than %d bytes", ErrInvalidIdempotencyKey, maxIdempotencyKeyLen)
+	}
+	for _, r := range key {
+		switch {
+		case 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z', '0' <= r && r <= '9':
+		case r == '.', r == '_', r == '-':
+		default:
+			return fmt.Errorf("%w: %q contains %q", ErrInvalidIdempotencyKey, key, r)
+		}
+	}
+	return nil
+}
+
+// withIdempotencyKey appends the marker for key to the details of an expense.
+func withIdempotencyKey(details, key string) string {
+	marker := "[" + idempotencyMarker + key + "]"
+	if details == "" {
+		return marker
+	}
+	return details + "\n\n" + marker
+}
+
+// IdempotencyKey returns the key recorded in the details of an expense created with
+// CreateExpenseRequest.IdempotencyKey.
+func (e *Expense) IdempotencyKey() (string, bool) {
+	start := strings.LastIndex(e.Details, "["+idempotencyMarker)
+	if start < 0 {
+		return "", false
+	}
+	rest := e.Details[start+len(idempotencyMarker)+1:]
+	end := strings.IndexByte(rest, ']')
+	if end < 0 {
+		return "", false
+	}
+	return rest[:end], true
+}
+
+// FindExpenseByIdempotencyKey searches the expenses updated within the last day for one
+// created with the given idempotency key, returning ErrNotFound if there is none.
+//
+// Deleted expenses are ignored.
+func (c *Client) FindExpenseByIdempotencyKey(ctx context.Context, key string) (*Expense, error) {
+	since := time.Now().Add(-idempotencyWindow)
+	req := &GetExpensesRequest{
+		UpdatedAfter: &since,
+		Limit:        idempotencyPage,
+	}
+	it := c.Expenses(ctx, req)
+	for it.Next() {
+		e := it.Expense()
+		if k, ok := e.IdempotencyKey(); ok && k == key && e.DeletedAt == nil {
+			return &e, nil
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	return nil, ErrNotFound
+}
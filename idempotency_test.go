@@ -0,0 +1,118 @@
+package splitwise
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestIdempotentCreateExpense(t *testing.T) {
+	var created []Expense
+	client, counts := newCountingClient(t, func(rw http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3.0/create_expense":
+			r.ParseForm()
+			e := Expense{
+				ID:          len(created) + 1,
+				Description: r.PostForm.Get("description"),
+				Details:     r.PostForm.Get("details"),
+			}
+			created = append(created, e)
+			json.NewEncoder(rw).Encode(map[string]interface{}{"expense": e})
+		case "/api/v3.0/get_expenses":
+			if r.URL.Query().Get("updated_after") == "" {
+				t.Errorf("expected the search to be limited to recent expenses")
+			}
+			json.NewEncoder(rw).Encode(map[string]interface{}{"expenses": created})
+		}
+	})
+
+	ctx := context.Background()
+	req := CreateExpenseRequest{
+		Cost:           "10.00",
+		Description:    "taxi",
+		Details:        stringPtr("to the airport"),
+		SplitStrategy:  SplitEqually(1),
+		IdempotencyKey: "abc-123",
+	}
+	first, err := client.CreateExpense(ctx, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if key, ok := first.IdempotencyKey(); !ok || key != "abc-123" {
+		t.Fatalf("expected the key to be recorded, got %q in %q", key, first.Details)
+	}
+	second, err := client.CreateExpense(ctx, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if second.ID != first.ID {
+		t.Errorf("expected the existing expense %d, got %d", first.ID, second.ID)
+	}
+	if n := counts["/api/v3.0/create_expense"]; n != 1 {
+		t.Errorf("expected 1 expense to be created, got %d", n)
+	}
+
+	req.IdempotencyKey = "def-456"
+	if third, err := client.CreateExpense(ctx, req); err != nil || third.ID == first.ID {
+		t.Errorf("expected a new expense for a different key, got %+v, %v", third, err)
+	}
+}
+
+func TestExpenseIdempotencyKey(t *testing.T) {
+	cases := []struct {
+		details string
+		key     string
+		ok      bool
+	}{
+		{details: withIdempotencyKey("", "k1"), key: "k1", ok: true},
+		{details: withIdempotencyKey("notes [with brackets]", "k2"), key: "k2", ok: true},
+		{details: "no key here"},
+		{details: "[idempotency-key:unterminated"},
+	}
+	for _, tc := range cases {
+		e := Expense{Details: tc.details}
+		key, ok := e.IdempotencyKey()
+		if key != tc.key || ok != tc.ok {
+			t.Errorf("IdempotencyKey(%q) = %q, %v; expected %q, %v", tc.details, key, ok, tc.key, tc.ok)
+		}
+	}
+}
+
+func TestCreateExpenseInvalidIdempotencyKey(t *testing.T) {
+	client, counts := newCountingClient(t, func(rw http.ResponseWriter, r *http.Request) {
+		rw.Write([]byte(`{"expenses": [], "expense": {"id": 1}}`))
+	})
+	keys := []string{
+		"a]b",
+		"two\nlines",
+		"with space",
+		"ünicode",
+		strings.Repeat("k", maxIdempotencyKeyLen+1),
+	}
+	for _, key := range keys {
+		_, err := client.CreateExpense(context.Background(), CreateExpenseRequest{
+			Cost:           "10.00",
+			SplitStrategy:  SplitEqually(1),
+			IdempotencyKey: key,
+		})
+		if !errors.Is(err, ErrInvalidIdempotencyKey) {
+			t.Errorf("expected key %q to be rejected, got %v", key, err)
+		}
+	}
+	if len(counts) != 0 {
+		t.Errorf("expected no requests, got %v", counts)
+	}
+
+	valid := "Order_2021-03.01-" + strings.Repeat("k", maxIdempotencyKeyLen-17)
+	if _, err := client.CreateExpense(context.Background(), CreateExpenseRequest{
+		Cost:           "10.00",
+		SplitStrategy:  SplitEqually(1),
+		IdempotencyKey: valid,
+	}); err != nil {
+		t.Errorf("expected key %q to be accepted, got %s", valid, err)
+	}
+}
@@ -0,0 +1,315 @@
+// Package importer creates expenses from the rows of CSV files such as bank statements.
+//
+// Rows are converted into expenses according to a declarative Mapping. Each imported
+// expense records the identity of the row it came from as its idempotency key, so
+// importing the same file again skips the rows which have already been imported:
+//
+//	im, err := importer.New(client, mapping)
+//	rows, err := im.Plan(ctx, file)   // preview without creating anything
+//	rows, err = im.Import(ctx, file)
+package importer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+)
+
+// Status is the outcome of importing a row.
+type Status string
+
+const (
+	// StatusNew rows will be, or have been, imported.
+	StatusNew Status = "new"
+	// StatusDuplicate rows were imported previously.
+	StatusDuplicate Status = "duplicate"
+	// StatusSkipped rows are excluded by the mapping, e.g. because of their sign.
+	StatusSkipped Status = "skipped"
+	// StatusInvalid rows could not be converted into an expense.
+	StatusInvalid Status = "invalid"
+	// StatusFailed rows were rejected by the API.
+	StatusFailed Status = "failed"
+)
+
+// Row is a row of the CSV file and the expense planned for it.
+type Row struct {
+	// Line is the line number of the row in the file.
+	Line   int
+	Key    string
+	Status Status
+	// Reason explains why a row was skipped, invalid or failed.
+	Reason  string
+	Request splitwise.CreateExpenseRequest
+	// Expense is the expense created for the row by Import.
+	Expense *splitwise.Expense
+}
+
+// Importer imports CSV files according to a Mapping.
+type Importer struct {
+	client      *splitwise.Client
+	mapping     Mapping
+	description *template.Template
+}
+
+// New creates an Importer, returning an error if the mapping is invalid.
+func New(client *splitwise.Client, mapping Mapping) (*Importer, error) {
+	tmpl, err := mapping.compile()
+	if err != nil {
+		return nil, err
+	}
+	return &Importer{
+		client:      client,
+		mapping:     mapping,
+		description: tmpl,
+	}, nil
+}
+
+// Plan reads the CSV file from r and returns the expense planned for each row, without
+// creating anything.
+func (im *Importer) Plan(ctx context.Context, r io.Reader) ([]Row, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+	for i := range header {
+		header[i] = strings.TrimSpace(header[i])
+	}
+
+	var rows []Row
+	occurrences := make(map[string]int)
+	for line := 2; ; line++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		fields := make(map[string]string, len(header))
+		for i, name := range header {
+			if i < len(record) {
+				fields[name] = record[i]
+			}
+		}
+		// Identical rows, such as two purchases of the same amount on the same day,
+		// are told apart by the order in which they occur.
+		key := rowKey(&im.mapping, fields)
+		occurrences[key]++
+		if n := occurrences[key]; n > 1 {
+			key = fmt.Sprintf("%s-%d", key, n)
+		}
+		rows = append(rows, im.planRow(line, key, fields))
+	}
+
+	imported, err := im.importedKeys(ctx, rows)
+	if err != nil {
+		return nil, fmt.Errorf("find imported rows: %w", err)
+	}
+	for i := range rows {
+		if rows[i].Status == StatusNew && imported[rows[i].Request.IdempotencyKey] {
+			rows[i].Status = StatusDuplicate
+		}
+	}
+	return rows, nil
+}
+
+// Import reads the CSV file from r and creates an expense for each new row.
+//
+// Rows which fail to be created are marked StatusFailed and do not stop the import.
+func (im *Importer) Import(ctx context.Context, r io.Reader) ([]Row, error) {
+	rows, err := im.Plan(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		row := &rows[i]
+		if row.Status != StatusNew {
+			continue
+		}
+		expense, err := im.client.CreateExpense(ctx, row.Request)
+		if err != nil {
+			if ctx.Err() != nil {
+				return rows, ctx.Err()
+			}
+			row.Status = StatusFailed
+			row.Reason = err.Error()
+			continue
+		}
+		row.Expense = expense
+	}
+	return rows, nil
+}
+
+func (im *Importer) planRow(line int, key string, fields map[string]string) Row {
+	m := &im.mapping
+	row := Row{
+		Line:   line,
+		Key:    key,
+		Status: StatusNew,
+	}
+	invalid := func(format string, args ...interface{}) Row {
+		row.Status = StatusInvalid
+		row.Reason = fmt.Sprintf(format, args...)
+		return row
+	}
+
+	date, err := m.parseDate(fields[m.Date])
+	if err != nil {
+		return invalid("date: %s", err)
+	}
+	cost, include, err := m.parseCost(fields[m.Amount])
+	if err != nil {
+		return invalid("%s", err)
+	}
+	if !include {
+		row.Status = StatusSkipped
+		row.Reason = "amount excluded by sign"
+		return row
+	}
+	var sb strings.Builder
+	if err := im.description.Execute(&sb, fields); err != nil {
+		return invalid("description: %s", err)
+	}
+
+	currency := m.Currency
+	if m.CurrencyColumn != "" {
+		currency = strings.TrimSpace(fields[m.CurrencyColumn])
+	}
+	row.Request = splitwise.CreateExpenseRequest{
+		Cost:           cost.FloatString(2),
+		Description:    strings.TrimSpace(sb.String()),
+		Date:           &date,
+		SplitStrategy:  im.split(cost),
+		IdempotencyKey: idempotencyKey(row.Key),
+	}
+	if currency != "" {
+		row.Request.CurrencyCode = &currency
+	}
+	if m.CategoryID != 0 {
+		categoryID := m.CategoryID
+		row.Request.CategoryID = &categoryID
+	}
+	return row
+}
+
+// split returns the SplitStrategy for an expense of the given cost.
+func (im *Importer) split(cost *big.Rat) splitwise.SplitStrategy {
+	s := im.mapping.Split
+	if len(s.Shares) == 0 {
+		return splitwise.SplitEqually(s.GroupID)
+	}
+	ids := make([]int, 0, len(s.Shares))
+	for id := range s.Shares {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	// Round every share to cents, giving any remainder to the last user so that
+	// the shares always sum to the cost.
+	hundred := big.NewRat(100, 1)
+	remaining, _ := new(big.Rat).SetString(cost.FloatString(2))
+	var shares []splitwise.UserShare
+	for i, id := range ids {
+		pct, _ := new(big.Rat).SetString(s.Shares[id])
+		owed := new(big.Rat).Mul(cost, pct)
+		owed.Quo(owed, hundred)
+		owed, _ = new(big.Rat).SetString(owed.FloatString(2))
+		if i == len(ids)-1 {
+			owed = remaining
+		}
+		remaining = new(big.Rat).Sub(remaining, owed)
+
+		share := splitwise.UserShare{
+			UserOption: splitwise.ExistingUser(id),
+			OwedShare:  owed.FloatString(2),
+			PaidShare:  "0.00",
+		}
+		if id == s.PaidBy {
+			share.PaidShare = cost.FloatString(2)
+		}
+		shares = append(shares, share)
+	}
+	if _, ok := s.Shares[s.PaidBy]; !ok {
+		shares = append(shares, splitwise.UserShare{
+			UserOption: splitwise.ExistingUser(s.PaidBy),
+			OwedShare:  "0.00",
+			PaidShare:  cost.FloatString(2),
+		})
+	}
+	return splitwise.SplitManually(shares...)
+}
+
+// importedKeys returns the idempotency keys of the rows which were imported previously,
+// found by searching the expenses dated within the range of the rows.
+func (im *Importer) importedKeys(ctx context.Context, rows []Row) (map[string]bool, error) {
+	var first, last time.Time
+	for _, row := range rows {
+		if row.Status != StatusNew {
+			continue
+		}
+		date := *row.Request.Date
+		if first.IsZero() || date.Before(first) {
+			first = date
+		}
+		if last.IsZero() || date.After(last) {
+			last = date
+		}
+	}
+	imported := make(map[string]bool)
+	if first.IsZero() {
+		return imported, nil
+	}
+	// Widen the range to allow for the server storing dates in another timezone.
+	after := first.AddDate(0, 0, -1)
+	before := last.AddDate(0, 0, 1)
+	it := im.client.Expenses(ctx, &splitwise.GetExpensesRequest{
+		DatedAfter:  &after,
+		DatedBefore: &before,
+	})
+	for it.Next() {
+		e := it.Expense()
+		if key, ok := e.IdempotencyKey(); ok && e.DeletedAt == nil {
+			imported[key] = true
+		}
+	}
+	return imported, it.Err()
+}
+
+// idempotencyKey returns the idempotency key of the expense created for the row with
+// the given key. Row keys taken from an ID column may hold any text, so they are hashed
+// to meet the restrictions on idempotency keys.
+func idempotencyKey(rowKey string) string {
+	sum := sha256.Sum256([]byte(rowKey))
+	return "import-" + hex.EncodeToString(sum[:16])
+}
+
+// rowKey identifies a row, either by its ID column or by a hash of its contents.
+func rowKey(m *Mapping, fields map[string]string) string {
+	if m.ID != "" {
+		if id := strings.TrimSpace(fields[m.ID]); id != "" {
+			return id
+		}
+	}
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	h := sha256.New()
+	for _, name := range names {
+		fmt.Fprintf(h, "%s=%s\x00", name, strings.TrimSpace(fields[name]))
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
@@ -0,0 +1,190 @@
+package importer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+)
+
+type testHTTPClient struct {
+	u      *url.URL
+	client http.Client
+}
+
+func (tc *testHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	req.URL.Host = tc.u.Host
+	req.URL.Scheme = tc.u.Scheme
+	return tc.client.Do(req)
+}
+
+// fakeAccount records created expenses and serves them from get_expenses.
+type fakeAccount struct {
+	expenses []splitwise.Expense
+	forms    []url.Values
+}
+
+func (fa *fakeAccount) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/api/v3.0/create_expense":
+		r.ParseForm()
+		fa.forms = append(fa.forms, r.PostForm)
+		date, _ := time.Parse(time.RFC3339, r.PostForm.Get("date"))
+		e := splitwise.Expense{
+			ID:          len(fa.expenses) + 1,
+			Date:        date,
+			Description: r.PostForm.Get("description"),
+			Details:     r.PostForm.Get("details"),
+			Cost:        r.PostForm.Get("cost"),
+		}
+		fa.expenses = append(fa.expenses, e)
+		json.NewEncoder(rw).Encode(map[string]interface{}{"expense": e})
+	case "/api/v3.0/get_expenses":
+		json.NewEncoder(rw).Encode(map[string]interface{}{"expenses": fa.expenses})
+	}
+}
+
+const statement = `Date,Payee,Memo,Amount
+01/03/2021,Corner Shop,groceries,-12.50
+02/03/2021,Employer,salary,"2,000.00"
+03/03/2021,Taxi Co,,-30
+03/03/2021,Taxi Co,,-30
+not a date,Nowhere,,-1
+`
+
+func newTestImporter(t *testing.T, mapping Mapping) (*Importer, *fakeAccount) {
+	account := &fakeAccount{}
+	server := httptest.NewServer(account)
+	t.Cleanup(server.Close)
+	u, _ := url.Parse(server.URL)
+	im, err := New(splitwise.NewClient(&testHTTPClient{u: u}), mapping)
+	if err != nil {
+		t.Fatalf("new: %s", err)
+	}
+	return im, account
+}
+
+func statuses(rows []Row) []Status {
+	var s []Status
+	for _, row := range rows {
+		s = append(s, row.Status)
+	}
+	return s
+}
+
+func TestImport(t *testing.T) {
+	im, account := newTestImporter(t, Mapping{
+		Date:        "Date",
+		DateFormat:  "02/01/2006",
+		Amount:      "Amount",
+		Sign:        SignNegate,
+		Description: "{{.Payee}}{{if .Memo}} ({{.Memo}}){{end}}",
+		Currency:    "GBP",
+		Split:       Split{GroupID: 42},
+	})
+	ctx := context.Background()
+
+	rows, err := im.Plan(ctx, strings.NewReader(statement))
+	if err != nil {
+		t.Fatalf("plan: %s", err)
+	}
+	expected := []Status{StatusNew, StatusSkipped, StatusNew, StatusNew, StatusInvalid}
+	if got := statuses(rows); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected statuses %v, got %v", expected, got)
+	}
+	if len(account.forms) != 0 {
+		t.Fatalf("expected plan not to create expenses")
+	}
+	req := rows[0].Request
+	if req.Cost != "12.50" || req.Description != "Corner Shop (groceries)" || *req.CurrencyCode != "GBP" {
+		t.Errorf("unexpected request: %+v", req)
+	}
+
+	rows, err = im.Import(ctx, strings.NewReader(statement))
+	if err != nil {
+		t.Fatalf("import: %s", err)
+	}
+	if len(account.forms) != 3 {
+		t.Fatalf("expected 3 expenses to be created, got %d", len(account.forms))
+	}
+	if form := account.forms[0]; form.Get("group_id") != "42" || form.Get("date") != "2021-03-01T00:00:00Z" {
+		t.Errorf("unexpected form: %v", form)
+	}
+	if rows[2].Key == rows[3].Key {
+		t.Errorf("expected identical rows to have distinct keys")
+	}
+	for i, row := range rows {
+		if row.Status != StatusNew {
+			continue
+		}
+		key, ok := account.expenses[row.Expense.ID-1].IdempotencyKey()
+		if !ok || key != row.Request.IdempotencyKey {
+			t.Errorf("row %d: expected idempotency key %q to be recorded, got %q", i, row.Request.IdempotencyKey, key)
+		}
+	}
+
+	rows, err = im.Import(ctx, strings.NewReader(statement))
+	if err != nil {
+		t.Fatalf("import: %s", err)
+	}
+	expected = []Status{StatusDuplicate, StatusSkipped, StatusDuplicate, StatusDuplicate, StatusInvalid}
+	if got := statuses(rows); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected statuses %v on re-import, got %v", expected, got)
+	}
+	if len(account.forms) != 3 {
+		t.Fatalf("expected no further expenses to be created")
+	}
+}
+
+func TestImportShares(t *testing.T) {
+	im, account := newTestImporter(t, Mapping{
+		Date:        "Date",
+		DateFormat:  "02/01/2006",
+		Amount:      "Amount",
+		Sign:        SignAbsolute,
+		Description: "{{.Payee}}",
+		Split: Split{
+			PaidBy: 1,
+			Shares: map[int]string{1: "33.33", 2: "66.67"},
+		},
+	})
+	if _, err := im.Import(context.Background(), strings.NewReader("Date,Payee,Amount\n01/03/2021,Hotel,-100.01\n")); err != nil {
+		t.Fatalf("import: %s", err)
+	}
+	form := account.forms[0]
+	expected := map[string]string{
+		"users__0__user_id":    "1",
+		"users__0__owed_share": "33.33",
+		"users__0__paid_share": "100.01",
+		"users__1__user_id":    "2",
+		"users__1__owed_share": "66.68",
+		"users__1__paid_share": "0.00",
+	}
+	for k, v := range expected {
+		if form.Get(k) != v {
+			t.Errorf("expected %s=%s, got %q", k, v, form.Get(k))
+		}
+	}
+}
+
+func TestInvalidMapping(t *testing.T) {
+	mappings := []Mapping{
+		{Amount: "Amount", Description: "x", Split: Split{GroupID: 1}},
+		{Date: "Date", Amount: "Amount", Split: Split{GroupID: 1}},
+		{Date: "Date", Amount: "Amount", Description: "x"},
+		{Date: "Date", Amount: "Amount", Description: "x", Split: Split{PaidBy: 1, Shares: map[int]string{1: "50"}}},
+		{Date: "Date", Amount: "Amount", Description: "x", Sign: "sideways", Split: Split{GroupID: 1}},
+	}
+	for _, m := range mappings {
+		if _, err := New(nil, m); err == nil {
+			t.Errorf("expected mapping %+v to be invalid", m)
+		}
+	}
+}
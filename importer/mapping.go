@@ -0,0 +1,140 @@
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// Sign controls how the amounts of a statement are converted into expense costs.
+type Sign string
+
+const (
+	// SignKeep uses amounts as they are. Rows with negative amounts are skipped.
+	SignKeep Sign = "keep"
+	// SignNegate negates amounts, for statements where debits are negative. Rows which
+	// become negative, i.e. credits, are skipped.
+	SignNegate Sign = "negate"
+	// SignAbsolute uses the absolute value of amounts.
+	SignAbsolute Sign = "abs"
+)
+
+// Mapping describes how the rows of a CSV file are converted into expenses.
+//
+// Columns are referred to by their name in the header row. A Mapping can be decoded
+// from JSON, e.g.
+//
+//	{
+//	  "date": "Transaction Date",
+//	  "date_format": "02/01/2006",
+//	  "amount": "Amount",
+//	  "sign": "negate",
+//	  "description": "{{.Payee}}",
+//	  "currency": "EUR",
+//	  "split": {"group_id": 1234}
+//	}
+type Mapping struct {
+	// Date is the column holding the date of each row.
+	Date string `json:"date"`
+	// DateFormat is the layout of dates, as understood by time.Parse. It defaults
+	// to "2006-01-02".
+	DateFormat string `json:"date_format"`
+	// Amount is the column holding the amount of each row.
+	Amount string `json:"amount"`
+	// Sign controls how amounts are converted into costs. It defaults to SignKeep.
+	Sign Sign `json:"sign"`
+	// Description is a text/template executed with a map of column name to value,
+	// e.g. "{{.Payee}}" or `{{index . "Transaction Description"}}`.
+	Description string `json:"description"`
+	// ID is an optional column which uniquely identifies each row. If empty, rows are
+	// identified by their contents.
+	ID string `json:"id"`
+	// CurrencyColumn is an optional column holding the currency code of each row.
+	CurrencyColumn string `json:"currency_column"`
+	// Currency is the currency code used when there is no CurrencyColumn.
+	Currency string `json:"currency"`
+	// CategoryID is the category assigned to every expense.
+	CategoryID int `json:"category_id"`
+	// Split is how every expense is divided.
+	Split Split `json:"split"`
+}
+
+// Split describes how each imported expense is divided.
+type Split struct {
+	// GroupID splits each expense equally between the members of a group.
+	GroupID int `json:"group_id"`
+
+	// PaidBy is the user who paid for each expense when splitting with Shares.
+	PaidBy int `json:"paid_by"`
+	// Shares maps user IDs to the percentage of each expense they owe, e.g. "50".
+	//
+	// Expenses split with Shares are not part of any group.
+	Shares map[int]string `json:"shares"`
+}
+
+func (m *Mapping) compile() (*template.Template, error) {
+	if m.Date == "" || m.Amount == "" {
+		return nil, errors.New("mapping must include the date and amount columns")
+	}
+	if m.Description == "" {
+		return nil, errors.New("mapping must include a description")
+	}
+	if m.Split.GroupID == 0 && len(m.Split.Shares) == 0 {
+		return nil, errors.New("mapping must include a group or shares to split by")
+	}
+	if len(m.Split.Shares) > 0 {
+		total := new(big.Rat)
+		for id, pct := range m.Split.Shares {
+			r, ok := new(big.Rat).SetString(pct)
+			if !ok {
+				return nil, fmt.Errorf("invalid share %q for user %d", pct, id)
+			}
+			total.Add(total, r)
+		}
+		if total.Cmp(big.NewRat(100, 1)) != 0 {
+			return nil, fmt.Errorf("shares must total 100, got %s", total.FloatString(2))
+		}
+		if m.Split.PaidBy == 0 {
+			return nil, errors.New("mapping must include who paid when splitting by shares")
+		}
+	}
+	switch m.Sign {
+	case "":
+		m.Sign = SignKeep
+	case SignKeep, SignNegate, SignAbsolute:
+	default:
+		return nil, fmt.Errorf("unknown sign %q", m.Sign)
+	}
+	if m.DateFormat == "" {
+		m.DateFormat = "2006-01-02"
+	}
+	tmpl, err := template.New("description").Option("missingkey=error").Parse(m.Description)
+	if err != nil {
+		return nil, fmt.Errorf("description: %w", err)
+	}
+	return tmpl, nil
+}
+
+func (m *Mapping) parseDate(value string) (time.Time, error) {
+	return time.Parse(m.DateFormat, strings.TrimSpace(value))
+}
+
+// parseCost converts an amount into a cost, returning false if the row should be
+// skipped because of its sign.
+func (m *Mapping) parseCost(value string) (*big.Rat, bool, error) {
+	cleaned := strings.NewReplacer(",", "", " ", "").Replace(value)
+	amount, ok := new(big.Rat).SetString(cleaned)
+	if !ok {
+		return nil, false, fmt.Errorf("invalid amount %q", value)
+	}
+	switch m.Sign {
+	case SignNegate:
+		amount.Neg(amount)
+	case SignAbsolute:
+		amount.Abs(amount)
+	}
+	return amount, amount.Sign() > 0, nil
+}
@@ -0,0 +1,451 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Spec is the subset of an OpenAPI 3.0 document understood by the generator.
+type Spec struct {
+	OpenAPI    string                           `json:"openapi"`
+	Paths      map[string]map[string]*Operation `json:"paths"`
+	Components struct {
+		Schemas map[string]*Schema `json:"schemas"`
+	} `json:"components"`
+}
+
+type Operation struct {
+	OperationID string           `json:"operationId"`
+	Parameters  []Parameter      `json:"parameters"`
+	RequestBody *Body            `json:"requestBody"`
+	Responses   map[string]*Body `json:"responses"`
+}
+
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *Schema `json:"schema"`
+}
+
+type Body struct {
+	Content map[string]struct {
+		Schema *Schema `json:"schema"`
+	} `json:"content"`
+}
+
+type Schema struct {
+	Ref         string             `json:"$ref"`
+	Type        string             `json:"type"`
+	Format      string             `json:"format"`
+	Nullable    bool               `json:"nullable"`
+	Description string             `json:"description"`
+	Enum        []string           `json:"enum"`
+	Items       *Schema            `json:"items"`
+	Properties  map[string]*Schema `json:"properties"`
+	Required    []string           `json:"required"`
+	AllOf       []*Schema          `json:"allOf"`
+}
+
+func parseSpec(data []byte) (*Spec, error) {
+	var spec Spec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(spec.OpenAPI, "3.") {
+		return nil, fmt.Errorf("unsupported OpenAPI version %q", spec.OpenAPI)
+	}
+	return &spec, nil
+}
+
+// generator writes the Go source for a Spec.
+type generator struct {
+	spec    *Spec
+	buf     bytes.Buffer
+	imports map[string]bool
+}
+
+func generate(spec *Spec, pkg string) ([]byte, error) {
+	g := &generator{spec: spec, imports: map[string]bool{"context": true}}
+	if err := g.schemas(); err != nil {
+		return nil, err
+	}
+	if err := g.operations(); err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by openapigen. DO NOT EDIT.\n\npackage %s\n\nimport (\n", pkg)
+	for _, path := range sortedKeys(g.imports) {
+		fmt.Fprintf(&out, "\t%q\n", path)
+	}
+	out.WriteString(")\n")
+	out.Write(g.buf.Bytes())
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format generated source: %s", err)
+	}
+	return src, nil
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// schemas writes a type for each schema in the components of the spec.
+func (g *generator) schemas() error {
+	schemas := g.spec.Components.Schemas
+	for _, name := range sortedKeys(schemas) {
+		s := schemas[name]
+		g.printf("\n// %s is generated from #/components/schemas/%s.\n", name, name)
+		if s.Description != "" {
+			g.printf("//\n// %s\n", s.Description)
+		}
+		switch {
+		case len(s.Enum) > 0:
+			if s.Type != "string" {
+				return fmt.Errorf("schema %s: only string enums are supported", name)
+			}
+			g.printf("type %s string\n\nconst (\n", name)
+			for _, v := range s.Enum {
+				g.printf("\t%s%s %s = %q\n", name, goName(v), name, v)
+			}
+			g.printf(")\n")
+		case len(s.AllOf) > 0 || len(s.Properties) > 0:
+			props, err := g.properties(s)
+			if err != nil {
+				return fmt.Errorf("schema %s: %s", name, err)
+			}
+			if err := g.structType(name, props, nil, false); err != nil {
+				return fmt.Errorf("schema %s: %s", name, err)
+			}
+		case s.Type == "object":
+			g.imports["encoding/json"] = true
+			g.printf("type %s = json.RawMessage\n", name)
+		default:
+			typ, err := g.goType(s)
+			if err != nil {
+				return fmt.Errorf("schema %s: %s", name, err)
+			}
+			g.printf("type %s %s\n", name, typ)
+		}
+	}
+	return nil
+}
+
+// properties returns the properties of an object schema, merging those of each
+// schema in allOf.
+func (g *generator) properties(s *Schema) (map[string]*Schema, error) {
+	if s.Ref != "" {
+		resolved, err := g.resolve(s.Ref)
+		if err != nil {
+			return nil, err
+		}
+		s = resolved
+	}
+	props := make(map[string]*Schema)
+	for _, sub := range s.AllOf {
+		subProps, err := g.properties(sub)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range subProps {
+			props[k] = v
+		}
+	}
+	for k, v := range s.Properties {
+		props[k] = v
+	}
+	return props, nil
+}
+
+// structType writes a struct with a field for each property. When optionalPointers is
+// set, properties not in required are pointers so that they can be left unset.
+func (g *generator) structType(name string, props map[string]*Schema, required []string, optionalPointers bool) error {
+	isRequired := make(map[string]bool)
+	for _, r := range required {
+		isRequired[r] = true
+	}
+	g.printf("type %s struct {\n", name)
+	for _, prop := range sortedKeys(props) {
+		typ, err := g.goType(props[prop])
+		if err != nil {
+			return fmt.Errorf("property %s: %s", prop, err)
+		}
+		if optionalPointers && !isRequired[prop] && !strings.HasPrefix(typ, "*") && !strings.HasPrefix(typ, "[]") {
+			typ = "*" + typ
+		}
+		g.printf("\t%s %s `json:%q`\n", goName(prop), typ, prop)
+	}
+	g.printf("}\n")
+	return nil
+}
+
+func (g *generator) resolve(ref string) (*Schema, error) {
+	const prefix = "#/components/schemas/"
+	if !strings.HasPrefix(ref, prefix) {
+		return nil, fmt.Errorf("unsupported reference %q", ref)
+	}
+	s, ok := g.spec.Components.Schemas[strings.TrimPrefix(ref, prefix)]
+	if !ok {
+		return nil, fmt.Errorf("undefined reference %q", ref)
+	}
+	return s, nil
+}
+
+// goType returns the Go type of a property or parameter schema.
+func (g *generator) goType(s *Schema) (string, error) {
+	if s.Ref != "" {
+		if _, err := g.resolve(s.Ref); err != nil {
+			return "", err
+		}
+		return s.Ref[strings.LastIndex(s.Ref, "/")+1:], nil
+	}
+	var typ string
+	switch s.Type {
+	case "string":
+		typ = "string"
+		if s.Format == "date-time" {
+			g.imports["time"] = true
+			typ = "time.Time"
+		}
+	case "integer":
+		typ = "int"
+	case "number":
+		typ = "float64"
+	case "boolean":
+		typ = "bool"
+	case "array":
+		if s.Items == nil {
+			return "", fmt.Errorf("array without items")
+		}
+		elem, err := g.goType(s.Items)
+		if err != nil {
+			return "", err
+		}
+		return "[]" + elem, nil
+	case "object":
+		if len(s.Properties) > 0 {
+			return "", fmt.Errorf("inline objects are not supported, use a $ref")
+		}
+		g.imports["encoding/json"] = true
+		return "json.RawMessage", nil
+	default:
+		return "", fmt.Errorf("unsupported type %q", s.Type)
+	}
+	if s.Nullable {
+		typ = "*" + typ
+	}
+	return typ, nil
+}
+
+type operation struct {
+	*Operation
+	name   string
+	method string
+	path   string
+}
+
+// operations writes the request and response types of each operation, the API
+// interface, its UnimplementedAPI stubs and the Operations table.
+func (g *generator) operations() error {
+	var ops []operation
+	for _, path := range sortedKeys(g.spec.Paths) {
+		for _, method := range sortedKeys(g.spec.Paths[path]) {
+			op := g.spec.Paths[path][method]
+			if op.OperationID == "" {
+				return fmt.Errorf("%s %s: missing operationId", method, path)
+			}
+			if methodName(strings.ToUpper(method)) == "" {
+				return fmt.Errorf("%s %s: unsupported method", method, path)
+			}
+			ops = append(ops, operation{
+				Operation: op,
+				name:      goName(op.OperationID),
+				method:    strings.ToUpper(method),
+				path:      path,
+			})
+		}
+	}
+
+	signatures := make([]string, len(ops))
+	for i, op := range ops {
+		sig, err := g.operationTypes(op)
+		if err != nil {
+			return fmt.Errorf("%s %s: %s", op.method, op.path, err)
+		}
+		signatures[i] = sig
+	}
+
+	g.printf("\n// API is implemented by clients of the splitwise API.\ntype API interface {\n")
+	for i, op := range ops {
+		g.printf("\t// %s is %s %s.\n\t%s\n", op.name, op.method, op.path, signatures[i])
+	}
+	g.printf("}\n")
+
+	g.printf("\n// UnimplementedAPI implements API by returning ErrNotImplemented from every method.\n")
+	g.printf("type UnimplementedAPI struct{}\n\nvar _ API = UnimplementedAPI{}\n")
+	for i := range ops {
+		g.printf("\nfunc (UnimplementedAPI) %s {\n\treturn nil, ErrNotImplemented\n}\n", signatures[i])
+	}
+
+	g.printf("\n// Operations describes every operation in the API.\nvar Operations = []Operation{\n")
+	for _, op := range ops {
+		g.printf("\t{ID: %q, Method: http.Method%s, Path: %q},\n", op.OperationID, methodName(op.method), op.path)
+	}
+	g.printf("}\n")
+	g.imports["net/http"] = true
+	return nil
+}
+
+// operationTypes writes the request and response types of op and returns the
+// signature of its method.
+func (g *generator) operationTypes(op operation) (string, error) {
+	args := []string{"ctx context.Context"}
+	reqProps := make(map[string]*Schema)
+	var required []string
+	for _, p := range op.Parameters {
+		if p.Schema == nil {
+			return "", fmt.Errorf("parameter %s has no schema", p.Name)
+		}
+		switch p.In {
+		case "path":
+			typ, err := g.goType(p.Schema)
+			if err != nil {
+				return "", fmt.Errorf("parameter %s: %s", p.Name, err)
+			}
+			args = append(args, fmt.Sprintf("%s %s", lowerFirst(goName(p.Name)), typ))
+		case "query":
+			reqProps[p.Name] = p.Schema
+			if p.Required {
+				required = append(required, p.Name)
+			}
+		default:
+			return "", fmt.Errorf("unsupported parameter location %q", p.In)
+		}
+	}
+	if op.RequestBody != nil {
+		schema, err := bodySchema(op.RequestBody, "application/x-www-form-urlencoded")
+		if err != nil {
+			return "", fmt.Errorf("request body: %s", err)
+		}
+		for k, v := range schema.Properties {
+			reqProps[k] = v
+		}
+		required = append(required, schema.Required...)
+	}
+	if len(reqProps) > 0 {
+		g.printf("\n// %sRequest holds the parameters of %s %s.\n", op.name, op.method, op.path)
+		sort.Strings(required)
+		if err := g.structType(op.name+"Request", reqProps, required, true); err != nil {
+			return "", err
+		}
+		args = append(args, fmt.Sprintf("req *%sRequest", op.name))
+	}
+
+	res, ok := op.Responses["200"]
+	if !ok {
+		return "", fmt.Errorf("missing 200 response")
+	}
+	schema, err := bodySchema(res, "application/json")
+	if err != nil {
+		return "", fmt.Errorf("response: %s", err)
+	}
+	g.printf("\n// %sResponse is the response to %s %s.\n", op.name, op.method, op.path)
+	if err := g.structType(op.name+"Response", schema.Properties, nil, false); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s(%s) (*%sResponse, error)", op.name, strings.Join(args, ", "), op.name), nil
+}
+
+func bodySchema(b *Body, contentType string) (*Schema, error) {
+	content, ok := b.Content[contentType]
+	if !ok || content.Schema == nil {
+		return nil, fmt.Errorf("no %s schema", contentType)
+	}
+	if content.Schema.Type != "object" {
+		return nil, fmt.Errorf("%s schema must be an object", contentType)
+	}
+	return content.Schema, nil
+}
+
+// initialisms are words written in upper case in Go names.
+var initialisms = map[string]bool{"id": true, "url": true}
+
+// goName converts a snake_case or camelCase name to an exported Go name.
+func goName(s string) string {
+	var words []string
+	start := 0
+	for i, r := range s {
+		switch {
+		case r == '_' || r == '-' || r == ' ':
+			words = append(words, s[start:i])
+			start = i + 1
+		case r >= 'A' && r <= 'Z' && i > start:
+			words = append(words, s[start:i])
+			start = i
+		}
+	}
+	words = append(words, s[start:])
+
+	var b strings.Builder
+	for _, w := range words {
+		if w == "" {
+			continue
+		}
+		if initialisms[strings.ToLower(w)] {
+			b.WriteString(strings.ToUpper(w))
+			continue
+		}
+		b.WriteString(strings.ToUpper(w[:1]) + strings.ToLower(w[1:]))
+	}
+	return b.String()
+}
+
+func lowerFirst(s string) string {
+	if s == strings.ToUpper(s) {
+		return strings.ToLower(s)
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+// methodName returns the name of the net/http constant for method without its Method
+// prefix, or "" if there is none.
+func methodName(method string) string {
+	switch method {
+	case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return method[:1] + strings.ToLower(method[1:])
+	}
+	return ""
+}
+
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch m := m.(type) {
+	case map[string]bool:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]*Schema:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]map[string]*Operation:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]*Operation:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	default:
+		panic(fmt.Sprintf("sortedKeys: unsupported type %T", m))
+	}
+	sort.Strings(keys)
+	return keys
+}
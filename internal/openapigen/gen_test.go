@@ -0,0 +1,70 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+)
+
+// TestGeneratedUpToDate fails if openapi/zz_generated.go was not regenerated after a
+// change to the description or the generator.
+func TestGeneratedUpToDate(t *testing.T) {
+	data, err := ioutil.ReadFile("../../openapi/splitwise.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec, err := parseSpec(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := generate(spec, "openapi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ioutil.ReadFile("../../openapi/zz_generated.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Error("openapi/zz_generated.go is out of date, run go generate ./openapi")
+	}
+}
+
+func TestGoName(t *testing.T) {
+	tests := map[string]string{
+		"id":                  "ID",
+		"group_id":            "GroupID",
+		"simplify_by_default": "SimplifyByDefault",
+		"getCurrentUser":      "GetCurrentUser",
+		"addUserToGroup":      "AddUserToGroup",
+		"invite_link":         "InviteLink",
+		"avatar_url":          "AvatarURL",
+	}
+	for in, want := range tests {
+		if got := goName(in); got != want {
+			t.Errorf("goName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	tests := map[string]string{
+		"version":   `{"openapi": "2.0"}`,
+		"ref":       `{"openapi": "3.0.3", "components": {"schemas": {"A": {"type": "object", "properties": {"b": {"$ref": "#/components/schemas/B"}}}}}}`,
+		"inline":    `{"openapi": "3.0.3", "components": {"schemas": {"A": {"type": "object", "properties": {"b": {"type": "object", "properties": {"c": {"type": "string"}}}}}}}}`,
+		"enum":      `{"openapi": "3.0.3", "components": {"schemas": {"A": {"type": "integer", "enum": ["1"]}}}}`,
+		"operation": `{"openapi": "3.0.3", "paths": {"/a": {"get": {"responses": {}}}}}`,
+		"response":  `{"openapi": "3.0.3", "paths": {"/a": {"get": {"operationId": "a", "responses": {}}}}}`,
+	}
+	for name, spec := range tests {
+		t.Run(name, func(t *testing.T) {
+			parsed, err := parseSpec([]byte(spec))
+			if err == nil {
+				_, err = generate(parsed, "openapi")
+			}
+			if err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
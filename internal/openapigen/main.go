@@ -0,0 +1,50 @@
+// Command openapigen generates Go model types and endpoint method stubs from an
+// OpenAPI 3.0 description of the splitwise API.
+//
+//	openapigen -spec splitwise.json -out zz_generated.go -package openapi
+//
+// It supports the subset of OpenAPI used by the vendored description in openapi/, and
+// is run by go generate in that package.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+)
+
+func main() {
+	specPath := flag.String("spec", "", "path to the OpenAPI description")
+	out := flag.String("out", "", "path of the generated file, or stdout if empty")
+	pkg := flag.String("package", "openapi", "package name of the generated file")
+	flag.Parse()
+
+	if err := run(*specPath, *out, *pkg); err != nil {
+		fmt.Fprintf(os.Stderr, "openapigen: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(specPath, out, pkg string) error {
+	if specPath == "" {
+		return fmt.Errorf("-spec is required")
+	}
+	data, err := ioutil.ReadFile(specPath)
+	if err != nil {
+		return err
+	}
+	spec, err := parseSpec(data)
+	if err != nil {
+		return fmt.Errorf("%s: %s", specPath, err)
+	}
+	src, err := generate(spec, pkg)
+	if err != nil {
+		return err
+	}
+	if out == "" {
+		_, err = os.Stdout.Write(src)
+		return err
+	}
+	return ioutil.WriteFile(out, src, 0644)
+}
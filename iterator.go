@@ -0,0 +1,76 @@
+package splitwise
+
+import "context"
+
+const defaultIteratorPageSize = 100
+
+// ExpenseIterator pages through the results of GetExpenses.
+//
+//	it := client.Expenses(ctx, &GetExpensesRequest{})
+//	for it.Next() {
+//		expense := it.Expense()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type ExpenseIterator struct {
+	ctx    context.Context
+	client *Client
+	req    GetExpensesRequest
+
+	page []Expense
+	cur  Expense
+	done bool
+	err  error
+}
+
+// Expenses returns an iterator over every expense matching req.
+//
+// If req.Limit is zero, expenses are fetched 100 at a time.
+func (c *Client) Expenses(ctx context.Context, req *GetExpensesRequest) *ExpenseIterator {
+	it := &ExpenseIterator{
+		ctx:    ctx,
+		client: c,
+		req:    *req,
+	}
+	if it.req.Limit <= 0 {
+		it.req.Limit = defaultIteratorPageSize
+	}
+	return it
+}
+
+// Next advances to the next expense, fetching another page if needed. It returns false
+// once there are no more expenses or an error occurs.
+func (it *ExpenseIterator) Next() bool {
+	for len(it.page) == 0 {
+		if it.done || it.err != nil {
+			return false
+		}
+		it.page, it.err = it.client.GetExpenses(it.ctx, &it.req)
+		if len(it.page) < it.req.Limit {
+			it.done = true
+		}
+	}
+	it.cur, it.page = it.page[0], it.page[1:]
+	return true
+}
+
+// Expense returns the current expense.
+func (it *ExpenseIterator) Expense() Expense {
+	return it.cur
+}
+
+// Err returns the error that stopped iteration, if any.
+func (it *ExpenseIterator) Err() error {
+	return it.err
+}
+
+// All consumes the rest of the iterator, returning the expenses it produced.
+func (it *ExpenseIterator) All() ([]Expense, error) {
+	var all []Expense
+	for it.Next() {
+		all = append(all, it.Expense())
+	}
+	return all, it.Err()
+}
@@ -0,0 +1,37 @@
+package splitwise
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func TestExpenseIterator(t *testing.T) {
+	client, counts := newCountingClient(t, func(rw http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		var page []Expense
+		for id := offset + 1; id <= offset+limit && id <= 5; id++ {
+			page = append(page, Expense{ID: id})
+		}
+		json.NewEncoder(rw).Encode(map[string]interface{}{"expenses": page})
+	})
+
+	expenses, err := client.Expenses(context.Background(), &GetExpensesRequest{Limit: 2}).All()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(expenses) != 5 {
+		t.Fatalf("expected 5 expenses, got %d", len(expenses))
+	}
+	for i, e := range expenses {
+		if e.ID != i+1 {
+			t.Errorf("expected expense %d at position %d, got %d", i+1, i, e.ID)
+		}
+	}
+	if n := counts["/api/v3.0/get_expenses"]; n != 3 {
+		t.Errorf("expected 3 pages to be fetched, got %d", n)
+	}
+}
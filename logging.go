@@ -0,0 +1,188 @@
+package splitwise
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+)
+
+// LogEntry is a structured record of a single API call.
+type LogEntry struct {
+	Operation string
+	Method    string
+	Endpoint  string
+	Status    int
+	Latency   time.Duration
+	// Fields holds the selected request fields, after redaction.
+	Fields map[string]string
+	Err    error
+}
+
+// Logger receives a LogEntry for each API call made by a Client.
+type Logger interface {
+	LogCall(ctx context.Context, entry LogEntry)
+}
+
+// LoggerFunc adapts an ordinary function to a Logger.
+type LoggerFunc func(ctx context.Context, entry LogEntry)
+
+func (f LoggerFunc) LogCall(ctx context.Context, entry LogEntry) {
+	f(ctx, entry)
+}
+
+// StdLogger returns a Logger which writes entries to l as key=value pairs.
+func StdLogger(l *log.Logger) Logger {
+	return LoggerFunc(func(ctx context.Context, entry LogEntry) {
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "op=%s method=%s endpoint=%s status=%d latency=%s",
+			entry.Operation, entry.Method, entry.Endpoint, entry.Status, entry.Latency)
+		keys := make([]string, 0, len(entry.Fields))
+		for k := range entry.Fields {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(&sb, " %s=%q", k, entry.Fields[k])
+		}
+		if entry.Err != nil {
+			fmt.Fprintf(&sb, " err=%q", entry.Err.Error())
+		}
+		l.Print(sb.String())
+	})
+}
+
+// Redactor transforms the value of a request field before it is logged.
+type Redactor func(value string) string
+
+var (
+	// RedactAll replaces the value entirely.
+	RedactAll Redactor = func(string) string { return "[REDACTED]" }
+
+	// RedactEmail hides the local part of an email address, keeping the domain.
+	RedactEmail Redactor = func(value string) string {
+		if i := strings.LastIndexByte(value, '@'); i >= 0 {
+			return "***" + value[i:]
+		}
+		return RedactAll(value)
+	}
+
+	// RedactName keeps only the first letter of a name.
+	RedactName Redactor = func(value string) string {
+		for _, r := range value {
+			return string(r) + "***"
+		}
+		return ""
+	}
+
+	// RedactNone logs the value as-is. It can be used to disable a default redaction.
+	RedactNone Redactor = func(value string) string { return value }
+)
+
+// defaultRedactions are the redactions applied to request fields unless overridden
+// in a LogConfig.
+var defaultRedactions = map[string]Redactor{
+	"email":           RedactEmail,
+	"user_email":      RedactEmail,
+	"first_name":      RedactName,
+	"last_name":       RedactName,
+	"user_first_name": RedactName,
+	"user_last_name":  RedactName,
+	"content":         RedactAll,
+	"token":           RedactAll,
+	"access_token":    RedactAll,
+	"refresh_token":   RedactAll,
+}
+
+// DefaultRedactions returns the redactions applied to request fields unless overridden
+// in a LogConfig.
+//
+// Fields within arrays such as "users__0__email" are matched by their final
+// component, i.e. "email". The map is a copy, so changing it has no effect.
+func DefaultRedactions() map[string]Redactor {
+	redactions := make(map[string]Redactor, len(defaultRedactions))
+	for name, r := range defaultRedactions {
+		redactions[name] = r
+	}
+	return redactions
+}
+
+// LogConfig controls what is recorded by WithLogger.
+type LogConfig struct {
+	// Fields restricts the request fields that are logged, matched the same way as
+	// redactions. If nil, all fields are logged.
+	Fields []string
+
+	// Redact sets the Redactor for a field, taking precedence over DefaultRedactions.
+	Redact map[string]Redactor
+}
+
+// WithLogger logs every API call made by a Client to logger.
+//
+// A nil config logs all request fields using DefaultRedactions. The config is read
+// once, so changing it afterwards has no effect.
+func WithLogger(logger Logger, config *LogConfig) Option {
+	return WithMiddleware(LoggingMiddleware(logger, config))
+}
+
+// LoggingMiddleware returns the Middleware used by WithLogger.
+func LoggingMiddleware(logger Logger, config *LogConfig) Middleware {
+	if config == nil {
+		config = &LogConfig{}
+	}
+	redactions := DefaultRedactions()
+	for name, r := range config.Redact {
+		redactions[name] = r
+	}
+	var selected map[string]bool
+	if config.Fields != nil {
+		selected = make(map[string]bool, len(config.Fields))
+		for _, f := range config.Fields {
+			selected[f] = true
+		}
+	}
+	return func(next Handler) Handler {
+		return func(ctx context.Context, op *Operation) error {
+			start := time.Now()
+			err := next(ctx, op)
+			entry := LogEntry{
+				Operation: op.Name,
+				Method:    op.Method,
+				Endpoint:  op.Path,
+				Status:    op.StatusCode,
+				Latency:   time.Since(start),
+				Fields:    make(map[string]string),
+				Err:       err,
+			}
+			for _, values := range []map[string][]string{op.Query, op.Values} {
+				for key, vs := range values {
+					if len(vs) == 0 {
+						continue
+					}
+					name := fieldName(key)
+					if selected != nil && !selected[name] {
+						continue
+					}
+					value := vs[0]
+					if r, ok := redactions[name]; ok {
+						value = r(value)
+					}
+					entry.Fields[key] = value
+				}
+			}
+			logger.LogCall(ctx, entry)
+			return err
+		}
+	}
+}
+
+// fieldName strips any array prefix from a request key, so that
+// "users__0__email" becomes "email".
+func fieldName(key string) string {
+	if i := strings.LastIndex(key, "__"); i >= 0 {
+		return key[i+2:]
+	}
+	return key
+}
@@ -0,0 +1,90 @@
+package splitwise
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"strings"
+	"testing"
+)
+
+func TestLoggingRedaction(t *testing.T) {
+	var entries []LogEntry
+	logger := LoggerFunc(func(ctx context.Context, entry LogEntry) {
+		entries = append(entries, entry)
+	})
+	_, err := makeRequest(201, "fixtures/create_expense.json", func(client *Client, ctx context.Context) error {
+		_, err := client.CreateExpense(ctx, CreateExpenseRequest{
+			Cost:        "20.00",
+			Description: "test",
+			SplitStrategy: SplitManually(
+				UserShare{
+					UserOption: NewUser(CreateFriendRequest{
+						FirstName: "Alan",
+						LastName:  "Turing",
+						Email:     "alan@example.com",
+					}),
+					PaidShare: "20.00",
+				},
+			),
+		})
+		return err
+	}, WithLogger(logger, &LogConfig{
+		Redact: map[string]Redactor{"last_name": RedactNone},
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(entries))
+	}
+	entry := entries[0]
+	if entry.Operation != "create_expense" || entry.Status != 201 {
+		t.Errorf("unexpected entry: %+v", entry)
+	}
+	expected := map[string]string{
+		"cost":                 "20.00",
+		"users__0__email":      "***@example.com",
+		"users__0__first_name": "A***",
+		"users__0__last_name":  "Turing",
+	}
+	for k, v := range expected {
+		if entry.Fields[k] != v {
+			t.Errorf("expected %s=%q, got %q", k, v, entry.Fields[k])
+		}
+	}
+}
+
+func TestLoggingSelectedFields(t *testing.T) {
+	var buf bytes.Buffer
+	logger := StdLogger(log.New(&buf, "", 0))
+	_, err := makeRequest(201, "fixtures/create_comment.json", func(client *Client, ctx context.Context) error {
+		_, err := client.CreateComment(ctx, 123, "hello, world!")
+		return err
+	}, WithLogger(logger, &LogConfig{Fields: []string{"expense_id"}}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	line := buf.String()
+	if !strings.Contains(line, `expense_id="123"`) {
+		t.Errorf("expected expense_id to be logged: %s", line)
+	}
+	if strings.Contains(line, "content") {
+		t.Errorf("expected content to be omitted: %s", line)
+	}
+}
+
+func TestDefaultRedactionsCopy(t *testing.T) {
+	DefaultRedactions()["content"] = RedactNone
+	var buf bytes.Buffer
+	_, err := makeRequest(201, "fixtures/create_comment.json", func(client *Client, ctx context.Context) error {
+		_, err := client.CreateComment(ctx, 123, "hello, world!")
+		return err
+	}, WithLogger(StdLogger(log.New(&buf, "", 0)), nil))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if strings.Contains(buf.String(), "hello") {
+		t.Errorf("expected content to stay redacted: %s", buf.String())
+	}
+}
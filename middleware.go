@@ -0,0 +1,94 @@
+package splitwise
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Operation describes a single call made by the Client to the splitwise API.
+//
+// Operations are passed through the middleware chain of a Client, which may inspect
+// or modify them before and after the request is sent.
+type Operation struct {
+	// Name is the logical name of the operation, e.g. "create_expense".
+	Name string
+	// Method is the HTTP method of the request.
+	Method string
+	// Path is the endpoint relative to the API root, e.g. "get_expense/123".
+	Path string
+	// Query holds the query parameters of the request.
+	Query url.Values
+	// Values holds the form values sent as the request body, if any.
+	Values url.Values
+	// Header holds any additional headers to send with the request.
+	Header http.Header
+	// Result is the value the response is decoded into.
+	//
+	// It is only populated once the next Handler has returned successfully.
+	Result interface{}
+	// StatusCode is the HTTP status of the response, or 0 if none was received.
+	StatusCode int
+	// ResponseHeader holds the headers of the response, if one was received.
+	ResponseHeader http.Header
+	// RawResponse is the undecoded body of a successful response.
+	RawResponse []byte
+	// DecodeWarnings holds the differences between the response and Result found by
+	// strict decoding. See WithStrictDecoding.
+	DecodeWarnings []DecodeWarning
+
+	// body is the request as a JSON object, sent instead of Values by WithJSONRequests.
+	body   map[string]interface{}
+	strict bool
+}
+
+// Handler performs an Operation, decoding the response into op.Result.
+type Handler func(ctx context.Context, op *Operation) error
+
+// Middleware wraps a Handler to add behavior around every call made by a Client.
+//
+// A Middleware is expected to call next in order to continue the chain, although it
+// may also choose to return early, e.g. to serve a cached result.
+type Middleware func(next Handler) Handler
+
+// WithMiddleware adds middleware to a Client.
+//
+// Middleware are run in the order given, so the first Middleware is the outermost and
+// sees each Operation first.
+func WithMiddleware(mw ...Middleware) Option {
+	return func(c *Client) {
+		c.middleware = append(c.middleware, mw...)
+	}
+}
+
+// Execute performs op through the middleware chain of the client, as if it had been
+// made by one of the client's methods.
+//
+// If op.Name is empty it is derived from op.Path.
+func (c *Client) Execute(ctx context.Context, op *Operation) error {
+	if op.Name == "" {
+		op.Name = operationName(op.Path)
+	}
+	op.strict = c.reportDecodeWarnings != nil
+	handler := Handler(c.send)
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		handler = c.middleware[i](handler)
+	}
+	err := handler(ctx, op)
+	if op.strict && len(op.DecodeWarnings) > 0 {
+		c.reportDecodeWarnings(op, op.DecodeWarnings)
+	}
+	return err
+}
+
+// operationName returns the logical name of the operation at the given endpoint.
+//
+// This is the endpoint with any trailing ID removed, so "get_expense/123" is named
+// "get_expense".
+func operationName(endpoint string) string {
+	if i := strings.IndexByte(endpoint, '/'); i >= 0 {
+		return endpoint[:i]
+	}
+	return endpoint
+}
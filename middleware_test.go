@@ -0,0 +1,86 @@
+package splitwise
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestMiddlewareOrder(t *testing.T) {
+	var calls []string
+	record := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(ctx context.Context, op *Operation) error {
+				calls = append(calls, name+":before:"+op.Name)
+				err := next(ctx, op)
+				calls = append(calls, name+":after")
+				return err
+			}
+		}
+	}
+	_, err := makeRequest(201, "fixtures/create_comment.json", func(client *Client, ctx context.Context) error {
+		_, err := client.CreateComment(ctx, 123, "hello, world!")
+		return err
+	}, WithMiddleware(record("outer"), record("inner")))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := []string{
+		"outer:before:create_comment",
+		"inner:before:create_comment",
+		"inner:after",
+		"outer:after",
+	}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Fatalf("expected calls %v, got %v", expected, calls)
+	}
+}
+
+func TestMiddlewareSeesOperation(t *testing.T) {
+	var seen Operation
+	var seenErr error
+	mw := func(next Handler) Handler {
+		return func(ctx context.Context, op *Operation) error {
+			seenErr = next(ctx, op)
+			seen = *op
+			return seenErr
+		}
+	}
+	_, err := makeRequest(201, "fixtures/create_comment.json", func(client *Client, ctx context.Context) error {
+		_, err := client.CreateComment(ctx, 123, "hello, world!")
+		return err
+	}, WithMiddleware(mw))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if seen.Values.Get("content") != "hello, world!" {
+		t.Errorf("expected content to be visible, got %v", seen.Values)
+	}
+	if seen.StatusCode != 201 {
+		t.Errorf("expected status 201, got %d", seen.StatusCode)
+	}
+	if seen.Result == nil {
+		t.Errorf("expected a decoded result")
+	}
+
+	_, err = makeRequest(403, "fixtures/auth_error.json", func(client *Client, ctx context.Context) error {
+		_, err := client.CreateComment(ctx, 123, "hello, world!")
+		return err
+	}, WithMiddleware(mw))
+	if !errors.Is(seenErr, UnexpectedStatus{Status: 403}) {
+		t.Errorf("expected middleware to see the error, got %v", seenErr)
+	}
+}
+
+func TestMiddlewareShortCircuit(t *testing.T) {
+	stub := func(next Handler) Handler {
+		return func(ctx context.Context, op *Operation) error {
+			return ErrNotFound
+		}
+	}
+	client := NewClient(nil, WithMiddleware(stub))
+	if _, err := client.GetExpense(context.Background(), 1); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
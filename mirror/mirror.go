@@ -0,0 +1,144 @@
+// Package mirror keeps a local copy of a splitwise account up to date.
+//
+// Each call to Sync only fetches the expenses updated since the previous sync, using
+// the updated_after filter of GetExpenses, so that large histories need only be
+// downloaded once:
+//
+//	m := mirror.New(client, mirror.NewFileStore("splitwise.json"))
+//	result, err := m.Sync(ctx)
+package mirror
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+)
+
+const defaultPageSize = 100
+
+// Mirror synchronizes the expenses, groups, friends and comments of an account
+// into a Store.
+type Mirror struct {
+	client *splitwise.Client
+	store  Store
+
+	pageSize int
+	comments bool
+}
+
+// Option configures a Mirror.
+type Option func(*Mirror)
+
+// WithPageSize sets the number of expenses requested per page.
+func WithPageSize(n int) Option {
+	return func(m *Mirror) {
+		m.pageSize = n
+	}
+}
+
+// WithComments enables mirroring the comments of each updated expense.
+//
+// This requires an additional request for every updated expense.
+func WithComments() Option {
+	return func(m *Mirror) {
+		m.comments = true
+	}
+}
+
+// New creates a Mirror of the account accessed by client.
+func New(client *splitwise.Client, store Store, opts ...Option) *Mirror {
+	m := &Mirror{
+		client:   client,
+		store:    store,
+		pageSize: defaultPageSize,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// Result summarizes the changes applied by a sync.
+type Result struct {
+	Updated []int
+	Deleted []int
+	Groups  int
+	Friends int
+}
+
+// Sync fetches all changes since the last sync and saves them to the Store.
+//
+// If Sync fails, the Store is left unchanged and the next sync resumes from the
+// same cursor.
+func (m *Mirror) Sync(ctx context.Context) (*Result, error) {
+	state, err := m.store.Load(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load: %w", err)
+	}
+	result := &Result{}
+
+	req := &splitwise.GetExpensesRequest{
+		Limit: m.pageSize,
+	}
+	if !state.Cursor.IsZero() {
+		cursor := state.Cursor
+		req.UpdatedAfter = &cursor
+	}
+	expenses, err := m.client.Expenses(ctx, req).All()
+	if err != nil {
+		return nil, fmt.Errorf("get expenses: %w", err)
+	}
+	for _, expense := range expenses {
+		if expense.UpdatedAt.After(state.Cursor) {
+			state.Cursor = expense.UpdatedAt
+		}
+		if expense.DeletedAt != nil {
+			delete(state.Expenses, expense.ID)
+			delete(state.Comments, expense.ID)
+			state.Deleted[expense.ID] = *expense.DeletedAt
+			result.Deleted = append(result.Deleted, expense.ID)
+			continue
+		}
+		delete(state.Deleted, expense.ID)
+		state.Expenses[expense.ID] = expense
+		result.Updated = append(result.Updated, expense.ID)
+
+		if m.comments {
+			comments, err := m.client.GetComments(ctx, expense.ID)
+			if err != nil {
+				return nil, fmt.Errorf("get comments for expense %d: %w", expense.ID, err)
+			}
+			state.Comments[expense.ID] = comments
+		}
+	}
+
+	// Groups and friends cannot be filtered by update time, but there are few
+	// enough of them to fetch in full.
+	groups, err := m.client.GetGroups(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get groups: %w", err)
+	}
+	state.Groups = make(map[int]splitwise.Group, len(groups))
+	for _, group := range groups {
+		state.Groups[group.ID] = group
+	}
+	result.Groups = len(groups)
+
+	friends, err := m.client.GetFriends(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get friends: %w", err)
+	}
+	state.Friends = make(map[int]splitwise.Friend, len(friends))
+	for _, friend := range friends {
+		state.Friends[friend.ID] = friend
+	}
+	result.Friends = len(friends)
+
+	state.LastSync = time.Now()
+	if err := m.store.Save(ctx, state); err != nil {
+		return nil, fmt.Errorf("save: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,146 @@
+package mirror
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+)
+
+type testHTTPClient struct {
+	u      *url.URL
+	client http.Client
+}
+
+func (tc *testHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	req.URL.Host = tc.u.Host
+	req.URL.Scheme = tc.u.Scheme
+	return tc.client.Do(req)
+}
+
+// fakeAccount serves the expenses it holds, honouring updated_after, limit and offset.
+type fakeAccount struct {
+	expenses []splitwise.Expense
+	queries  []url.Values
+}
+
+func (fa *fakeAccount) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/api/v3.0/get_expenses":
+		q := r.URL.Query()
+		fa.queries = append(fa.queries, q)
+		var matched []splitwise.Expense
+		for _, e := range fa.expenses {
+			if after := q.Get("updated_after"); after != "" {
+				t, _ := time.Parse(time.RFC3339, after)
+				if !e.UpdatedAt.After(t) {
+					continue
+				}
+			}
+			matched = append(matched, e)
+		}
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		if offset > len(matched) {
+			offset = len(matched)
+		}
+		matched = matched[offset:]
+		if limit > 0 && limit < len(matched) {
+			matched = matched[:limit]
+		}
+		json.NewEncoder(rw).Encode(map[string]interface{}{"expenses": matched})
+	case "/api/v3.0/get_comments":
+		id := r.URL.Query().Get("expense_id")
+		rw.Write([]byte(`{"comments": [{"id": 1, "content": "for expense ` + id + `"}]}`))
+	case "/api/v3.0/get_groups":
+		rw.Write([]byte(`{"groups": [{"id": 10, "name": "House", "group_type": "house"}]}`))
+	case "/api/v3.0/get_friends":
+		rw.Write([]byte(`{"friends": [{"id": 20, "first_name": "Grace"}]}`))
+	default:
+		rw.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newTestMirror(t *testing.T, account *fakeAccount, opts ...Option) (*Mirror, Store) {
+	server := httptest.NewServer(account)
+	t.Cleanup(server.Close)
+	u, _ := url.Parse(server.URL)
+	client := splitwise.NewClient(&testHTTPClient{u: u})
+	store := NewFileStore(filepath.Join(t.TempDir(), "mirror.json"))
+	return New(client, store, opts...), store
+}
+
+func expense(id int, updated time.Time) splitwise.Expense {
+	return splitwise.Expense{
+		ID:          id,
+		Description: "expense " + strconv.Itoa(id),
+		UpdatedAt:   updated,
+	}
+}
+
+func TestIncrementalSync(t *testing.T) {
+	ctx := context.Background()
+	t0 := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	account := &fakeAccount{}
+	for i := 1; i <= 5; i++ {
+		account.expenses = append(account.expenses, expense(i, t0.Add(time.Duration(i)*time.Minute)))
+	}
+	m, store := newTestMirror(t, account, WithPageSize(2), WithComments())
+
+	result, err := m.Sync(ctx)
+	if err != nil {
+		t.Fatalf("sync: %s", err)
+	}
+	if len(result.Updated) != 5 || result.Groups != 1 || result.Friends != 1 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	// Update one expense and delete another.
+	deletedAt := t0.Add(time.Hour)
+	account.expenses[1].Description = "renamed"
+	account.expenses[1].UpdatedAt = t0.Add(time.Hour)
+	account.expenses[3].DeletedAt = &deletedAt
+	account.expenses[3].UpdatedAt = t0.Add(time.Hour)
+	account.queries = nil
+
+	result, err = m.Sync(ctx)
+	if err != nil {
+		t.Fatalf("sync: %s", err)
+	}
+	if len(result.Updated) != 1 || result.Updated[0] != 2 {
+		t.Errorf("expected expense 2 to be updated, got %v", result.Updated)
+	}
+	if len(result.Deleted) != 1 || result.Deleted[0] != 4 {
+		t.Errorf("expected expense 4 to be deleted, got %v", result.Deleted)
+	}
+	if after := account.queries[0].Get("updated_after"); after != t0.Add(5*time.Minute).Format(time.RFC3339) {
+		t.Errorf("expected sync to resume from the cursor, got updated_after=%q", after)
+	}
+
+	state, err := store.Load(ctx)
+	if err != nil {
+		t.Fatalf("load: %s", err)
+	}
+	if len(state.Expenses) != 4 {
+		t.Errorf("expected 4 expenses, got %d", len(state.Expenses))
+	}
+	if state.Expenses[2].Description != "renamed" {
+		t.Errorf("expected expense 2 to be renamed, got %q", state.Expenses[2].Description)
+	}
+	if _, ok := state.Deleted[4]; !ok {
+		t.Errorf("expected a tombstone for expense 4")
+	}
+	if c := state.Comments[2]; len(c) != 1 || c[0].Content != "for expense 2" {
+		t.Errorf("unexpected comments: %+v", c)
+	}
+	if state.Groups[10].GroupType != splitwise.GroupTypeHouse {
+		t.Errorf("unexpected group: %+v", state.Groups[10])
+	}
+}
@@ -0,0 +1,93 @@
+package mirror
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+)
+
+// State is the mirrored contents of an account.
+type State struct {
+	// Cursor is the latest expense update seen so far. Only expenses updated after
+	// the cursor are fetched by the next sync.
+	Cursor time.Time `json:"cursor"`
+	// LastSync is the time at which the state was last synced, or zero if it never has been.
+	LastSync time.Time `json:"last_sync"`
+
+	Expenses map[int]splitwise.Expense `json:"expenses"`
+	// Deleted records the time at which each deleted expense was removed.
+	Deleted map[int]time.Time        `json:"deleted"`
+	Groups  map[int]splitwise.Group  `json:"groups"`
+	Friends map[int]splitwise.Friend `json:"friends"`
+	// Comments holds the comments of each expense, keyed by expense ID.
+	Comments map[int][]splitwise.Comment `json:"comments"`
+}
+
+// NewState returns an empty State.
+func NewState() *State {
+	return &State{
+		Expenses: make(map[int]splitwise.Expense),
+		Deleted:  make(map[int]time.Time),
+		Groups:   make(map[int]splitwise.Group),
+		Friends:  make(map[int]splitwise.Friend),
+		Comments: make(map[int][]splitwise.Comment),
+	}
+}
+
+// Store persists the State of a Mirror between syncs.
+type Store interface {
+	// Load returns the last saved State, or an empty State if none has been saved.
+	Load(ctx context.Context) (*State, error)
+	Save(ctx context.Context, state *State) error
+}
+
+type fileStore struct {
+	path string
+}
+
+// NewFileStore returns a Store which saves the State as JSON to the file at path.
+func NewFileStore(path string) Store {
+	return &fileStore{path: path}
+}
+
+func (fs *fileStore) Load(ctx context.Context) (*State, error) {
+	data, err := ioutil.ReadFile(fs.path)
+	if os.IsNotExist(err) {
+		return NewState(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	state := NewState()
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+func (fs *fileStore) Save(ctx context.Context, state *State) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	// Write to a temporary file first so that a failed save never leaves
+	// a partially written state behind.
+	tmp, err := ioutil.TempFile(filepath.Dir(fs.path), filepath.Base(fs.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), fs.path)
+}
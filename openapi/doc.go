@@ -0,0 +1,35 @@
+// Package openapi holds types generated from splitwise.json, an OpenAPI description of
+// the splitwise API.
+//
+// splitwise.json is not the description published by Splitwise. It is a partial one,
+// transcribed by hand from the API documentation examples also used in fixtures/, and
+// only covers the endpoints and models used by package splitwise. Fields it does not
+// describe are not checked. It should be replaced with the published description,
+// https://github.com/splitwise/api-docs, recording the revision it was taken from,
+// after which the package is regenerated and knownDifferences in package splitwise
+// rebuilt.
+//
+// The models mirror the schemas of the description and API has a method for each of
+// its operations. The hand-written types of package splitwise are checked against the
+// same description by its tests, but since both were written from the same examples,
+// the check only keeps them consistent: it cannot find changes made to the API until
+// the published description is used.
+//
+// The generated code is updated with go generate after changing splitwise.json.
+package openapi
+
+//go:generate go run ../internal/openapigen -spec splitwise.json -out zz_generated.go -package openapi
+
+import "errors"
+
+// ErrNotImplemented is returned by the methods of UnimplementedAPI.
+var ErrNotImplemented = errors.New("not implemented")
+
+// Operation describes an operation of the API.
+type Operation struct {
+	// ID is the operationId of the operation in the description.
+	ID     string
+	Method string
+	// Path is relative to the base URL of the API, with parameters in braces.
+	Path string
+}
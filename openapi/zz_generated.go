@@ -0,0 +1,596 @@
+// Code generated by openapigen. DO NOT EDIT.
+
+package openapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// Balance is generated from #/components/schemas/Balance.
+type Balance struct {
+	Amount       string `json:"amount"`
+	CurrencyCode string `json:"currency_code"`
+}
+
+// Category is generated from #/components/schemas/Category.
+type Category struct {
+	ID            int           `json:"id"`
+	Name          string        `json:"name"`
+	Subcategories []Subcategory `json:"subcategories"`
+}
+
+// Comment is generated from #/components/schemas/Comment.
+type Comment struct {
+	CommentType  string     `json:"comment_type"`
+	Content      string     `json:"content"`
+	CreatedAt    time.Time  `json:"created_at"`
+	DeletedAt    *time.Time `json:"deleted_at"`
+	ID           int        `json:"id"`
+	RelationID   int        `json:"relation_id"`
+	RelationType string     `json:"relation_type"`
+	User         User       `json:"user"`
+}
+
+// Currency is generated from #/components/schemas/Currency.
+type Currency struct {
+	CurrencyCode string `json:"currency_code"`
+	Unit         string `json:"unit"`
+}
+
+// CurrentUser is generated from #/components/schemas/CurrentUser.
+type CurrentUser struct {
+	DefaultCurrency    string               `json:"default_currency"`
+	Email              string               `json:"email"`
+	FirstName          string               `json:"first_name"`
+	ID                 int                  `json:"id"`
+	LastName           string               `json:"last_name"`
+	Locale             string               `json:"locale"`
+	Notifications      NotificationSettings `json:"notifications"`
+	NotificationsCount int                  `json:"notifications_count"`
+	NotificationsRead  *time.Time           `json:"notifications_read"`
+	Picture            Picture              `json:"picture"`
+	RegistrationStatus Registration         `json:"registration_status"`
+}
+
+// Debt is generated from #/components/schemas/Debt.
+type Debt struct {
+	Amount       string `json:"amount"`
+	CurrencyCode string `json:"currency_code"`
+	From         int    `json:"from"`
+	To           int    `json:"to"`
+}
+
+// Errors is generated from #/components/schemas/Errors.
+//
+// Errors reported by the API, either a list of messages or messages keyed by field.
+type Errors = json.RawMessage
+
+// Expense is generated from #/components/schemas/Expense.
+type Expense struct {
+	Category               Category       `json:"category"`
+	Comments               []Comment      `json:"comments"`
+	CommentsCount          int            `json:"comments_count"`
+	Cost                   string         `json:"cost"`
+	CreatedAt              time.Time      `json:"created_at"`
+	CreatedBy              User           `json:"created_by"`
+	CurrencyCode           string         `json:"currency_code"`
+	Date                   time.Time      `json:"date"`
+	DeletedAt              *time.Time     `json:"deleted_at"`
+	DeletedBy              User           `json:"deleted_by"`
+	Description            string         `json:"description"`
+	Details                *string        `json:"details"`
+	EmailReminder          bool           `json:"email_reminder"`
+	EmailReminderInAdvance int            `json:"email_reminder_in_advance"`
+	GroupID                *int           `json:"group_id"`
+	ID                     int            `json:"id"`
+	NextRepeat             *string        `json:"next_repeat"`
+	Payment                bool           `json:"payment"`
+	Receipt                Receipt        `json:"receipt"`
+	Repayments             []Repayment    `json:"repayments"`
+	RepeatInterval         RepeatInterval `json:"repeat_interval"`
+	Repeats                bool           `json:"repeats"`
+	TransactionConfirmed   bool           `json:"transaction_confirmed"`
+	UpdatedAt              time.Time      `json:"updated_at"`
+	UpdatedBy              User           `json:"updated_by"`
+	Users                  []Share        `json:"users"`
+}
+
+// Friend is generated from #/components/schemas/Friend.
+type Friend struct {
+	Balance            []Balance      `json:"balance"`
+	Email              string         `json:"email"`
+	FirstName          string         `json:"first_name"`
+	Groups             []GroupBalance `json:"groups"`
+	ID                 int            `json:"id"`
+	LastName           string         `json:"last_name"`
+	Picture            Picture        `json:"picture"`
+	RegistrationStatus Registration   `json:"registration_status"`
+	UpdatedAt          time.Time      `json:"updated_at"`
+}
+
+// Group is generated from #/components/schemas/Group.
+type Group struct {
+	GroupType         GroupType     `json:"group_type"`
+	ID                int           `json:"id"`
+	InviteLink        string        `json:"invite_link"`
+	Members           []GroupMember `json:"members"`
+	Name              string        `json:"name"`
+	OriginalDebts     []Debt        `json:"original_debts"`
+	SimplifiedDebts   []Debt        `json:"simplified_debts"`
+	SimplifyByDefault bool          `json:"simplify_by_default"`
+	UpdatedAt         time.Time     `json:"updated_at"`
+	Whiteboard        *string       `json:"whiteboard"`
+}
+
+// GroupBalance is generated from #/components/schemas/GroupBalance.
+type GroupBalance struct {
+	Balance []Balance `json:"balance"`
+	GroupID int       `json:"group_id"`
+}
+
+// GroupMember is generated from #/components/schemas/GroupMember.
+type GroupMember struct {
+	Balance            []Balance    `json:"balance"`
+	Email              string       `json:"email"`
+	FirstName          string       `json:"first_name"`
+	ID                 int          `json:"id"`
+	LastName           string       `json:"last_name"`
+	Picture            Picture      `json:"picture"`
+	RegistrationStatus Registration `json:"registration_status"`
+}
+
+// GroupType is generated from #/components/schemas/GroupType.
+type GroupType string
+
+const (
+	GroupTypeApartment GroupType = "apartment"
+	GroupTypeHouse     GroupType = "house"
+	GroupTypeTrip      GroupType = "trip"
+	GroupTypeOther     GroupType = "other"
+)
+
+// NotificationSettings is generated from #/components/schemas/NotificationSettings.
+type NotificationSettings struct {
+	AddedAsFriend  bool `json:"added_as_friend"`
+	AddedToGroup   bool `json:"added_to_group"`
+	Announcements  bool `json:"announcements"`
+	Bills          bool `json:"bills"`
+	ExpenseAdded   bool `json:"expense_added"`
+	ExpenseUpdated bool `json:"expense_updated"`
+	MonthlySummary bool `json:"monthly_summary"`
+	Payments       bool `json:"payments"`
+}
+
+// Picture is generated from #/components/schemas/Picture.
+type Picture struct {
+	Large  string `json:"large"`
+	Medium string `json:"medium"`
+	Small  string `json:"small"`
+}
+
+// Receipt is generated from #/components/schemas/Receipt.
+type Receipt struct {
+	Large    *string `json:"large"`
+	Original *string `json:"original"`
+}
+
+// Registration is generated from #/components/schemas/Registration.
+type Registration string
+
+const (
+	RegistrationDummy     Registration = "dummy"
+	RegistrationConfirmed Registration = "confirmed"
+	RegistrationInvited   Registration = "invited"
+)
+
+// Repayment is generated from #/components/schemas/Repayment.
+type Repayment struct {
+	Amount string `json:"amount"`
+	From   int    `json:"from"`
+	To     int    `json:"to"`
+}
+
+// RepeatInterval is generated from #/components/schemas/RepeatInterval.
+type RepeatInterval string
+
+const (
+	RepeatIntervalNever       RepeatInterval = "never"
+	RepeatIntervalWeekly      RepeatInterval = "weekly"
+	RepeatIntervalFortnightly RepeatInterval = "fortnightly"
+	RepeatIntervalMonthly     RepeatInterval = "monthly"
+	RepeatIntervalYearly      RepeatInterval = "yearly"
+)
+
+// Share is generated from #/components/schemas/Share.
+type Share struct {
+	NetBalance string `json:"net_balance"`
+	OwedShare  string `json:"owed_share"`
+	PaidShare  string `json:"paid_share"`
+	User       User   `json:"user"`
+	UserID     int    `json:"user_id"`
+}
+
+// Subcategory is generated from #/components/schemas/Subcategory.
+type Subcategory struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// User is generated from #/components/schemas/User.
+type User struct {
+	Email              string       `json:"email"`
+	FirstName          string       `json:"first_name"`
+	ID                 int          `json:"id"`
+	LastName           string       `json:"last_name"`
+	Picture            Picture      `json:"picture"`
+	RegistrationStatus Registration `json:"registration_status"`
+}
+
+// AddUserToGroupRequest holds the parameters of POST /add_user_to_group.
+type AddUserToGroupRequest struct {
+	Email     *string `json:"email"`
+	FirstName *string `json:"first_name"`
+	GroupID   int     `json:"group_id"`
+	LastName  *string `json:"last_name"`
+	UserID    *int    `json:"user_id"`
+}
+
+// AddUserToGroupResponse is the response to POST /add_user_to_group.
+type AddUserToGroupResponse struct {
+	Errors  Errors `json:"errors"`
+	Success bool   `json:"success"`
+}
+
+// CreateCommentRequest holds the parameters of POST /create_comment.
+type CreateCommentRequest struct {
+	Content   string `json:"content"`
+	ExpenseID int    `json:"expense_id"`
+}
+
+// CreateCommentResponse is the response to POST /create_comment.
+type CreateCommentResponse struct {
+	Comment Comment `json:"comment"`
+	Errors  Errors  `json:"errors"`
+}
+
+// CreateExpenseRequest holds the parameters of POST /create_expense.
+type CreateExpenseRequest struct {
+	CategoryID     *int            `json:"category_id"`
+	Cost           string          `json:"cost"`
+	CurrencyCode   *string         `json:"currency_code"`
+	Date           *time.Time      `json:"date"`
+	Description    string          `json:"description"`
+	Details        *string         `json:"details"`
+	GroupID        *int            `json:"group_id"`
+	Payment        *bool           `json:"payment"`
+	RepeatInterval *RepeatInterval `json:"repeat_interval"`
+}
+
+// CreateExpenseResponse is the response to POST /create_expense.
+type CreateExpenseResponse struct {
+	Errors  Errors  `json:"errors"`
+	Expense Expense `json:"expense"`
+}
+
+// CreateFriendRequest holds the parameters of POST /create_friend.
+type CreateFriendRequest struct {
+	UserEmail     string  `json:"user_email"`
+	UserFirstName *string `json:"user_first_name"`
+	UserLastName  *string `json:"user_last_name"`
+}
+
+// CreateFriendResponse is the response to POST /create_friend.
+type CreateFriendResponse struct {
+	Friend Friend `json:"friend"`
+}
+
+// CreateGroupRequest holds the parameters of POST /create_group.
+type CreateGroupRequest struct {
+	GroupType         *GroupType `json:"group_type"`
+	Name              string     `json:"name"`
+	SimplifyByDefault *bool      `json:"simplify_by_default"`
+	Whiteboard        *string    `json:"whiteboard"`
+}
+
+// CreateGroupResponse is the response to POST /create_group.
+type CreateGroupResponse struct {
+	Errors Errors `json:"errors"`
+	Group  Group  `json:"group"`
+}
+
+// DeleteCommentResponse is the response to POST /delete_comment/{id}.
+type DeleteCommentResponse struct {
+	Comment Comment `json:"comment"`
+	Errors  Errors  `json:"errors"`
+}
+
+// DeleteExpenseResponse is the response to POST /delete_expense/{id}.
+type DeleteExpenseResponse struct {
+	Errors  Errors `json:"errors"`
+	Success bool   `json:"success"`
+}
+
+// DeleteFriendResponse is the response to POST /delete_friend/{id}.
+type DeleteFriendResponse struct {
+	Errors  Errors `json:"errors"`
+	Success bool   `json:"success"`
+}
+
+// DeleteGroupResponse is the response to POST /delete_group/{id}.
+type DeleteGroupResponse struct {
+	Errors  Errors `json:"errors"`
+	Success bool   `json:"success"`
+}
+
+// GetCategoriesResponse is the response to GET /get_categories.
+type GetCategoriesResponse struct {
+	Categories []Category `json:"categories"`
+}
+
+// GetCommentsRequest holds the parameters of GET /get_comments.
+type GetCommentsRequest struct {
+	ExpenseID int `json:"expense_id"`
+}
+
+// GetCommentsResponse is the response to GET /get_comments.
+type GetCommentsResponse struct {
+	Comments []Comment `json:"comments"`
+}
+
+// GetCurrenciesResponse is the response to GET /get_currencies.
+type GetCurrenciesResponse struct {
+	Currencies []Currency `json:"currencies"`
+}
+
+// GetCurrentUserResponse is the response to GET /get_current_user.
+type GetCurrentUserResponse struct {
+	User CurrentUser `json:"user"`
+}
+
+// GetExpenseResponse is the response to GET /get_expense/{id}.
+type GetExpenseResponse struct {
+	Expense Expense `json:"expense"`
+}
+
+// GetExpensesRequest holds the parameters of GET /get_expenses.
+type GetExpensesRequest struct {
+	DatedAfter    *time.Time `json:"dated_after"`
+	DatedBefore   *time.Time `json:"dated_before"`
+	FriendID      *int       `json:"friend_id"`
+	GroupID       *int       `json:"group_id"`
+	Limit         *int       `json:"limit"`
+	Offset        *int       `json:"offset"`
+	UpdatedAfter  *time.Time `json:"updated_after"`
+	UpdatedBefore *time.Time `json:"updated_before"`
+}
+
+// GetExpensesResponse is the response to GET /get_expenses.
+type GetExpensesResponse struct {
+	Expenses []Expense `json:"expenses"`
+}
+
+// GetFriendResponse is the response to GET /get_friend/{id}.
+type GetFriendResponse struct {
+	Friend Friend `json:"friend"`
+}
+
+// GetFriendsResponse is the response to GET /get_friends.
+type GetFriendsResponse struct {
+	Friends []Friend `json:"friends"`
+}
+
+// GetGroupResponse is the response to GET /get_group/{id}.
+type GetGroupResponse struct {
+	Group Group `json:"group"`
+}
+
+// GetGroupsResponse is the response to GET /get_groups.
+type GetGroupsResponse struct {
+	Groups []Group `json:"groups"`
+}
+
+// GetUserResponse is the response to GET /get_user/{id}.
+type GetUserResponse struct {
+	User User `json:"user"`
+}
+
+// RemoveUserFromGroupRequest holds the parameters of POST /remove_user_from_group.
+type RemoveUserFromGroupRequest struct {
+	GroupID int `json:"group_id"`
+	UserID  int `json:"user_id"`
+}
+
+// RemoveUserFromGroupResponse is the response to POST /remove_user_from_group.
+type RemoveUserFromGroupResponse struct {
+	Errors  Errors `json:"errors"`
+	Success bool   `json:"success"`
+}
+
+// UndeleteExpenseResponse is the response to POST /undelete_expense/{id}.
+type UndeleteExpenseResponse struct {
+	Errors  Errors `json:"errors"`
+	Success bool   `json:"success"`
+}
+
+// UndeleteGroupResponse is the response to POST /undelete_group/{id}.
+type UndeleteGroupResponse struct {
+	Errors  Errors `json:"errors"`
+	Success bool   `json:"success"`
+}
+
+// API is implemented by clients of the splitwise API.
+type API interface {
+	// AddUserToGroup is POST /add_user_to_group.
+	AddUserToGroup(ctx context.Context, req *AddUserToGroupRequest) (*AddUserToGroupResponse, error)
+	// CreateComment is POST /create_comment.
+	CreateComment(ctx context.Context, req *CreateCommentRequest) (*CreateCommentResponse, error)
+	// CreateExpense is POST /create_expense.
+	CreateExpense(ctx context.Context, req *CreateExpenseRequest) (*CreateExpenseResponse, error)
+	// CreateFriend is POST /create_friend.
+	CreateFriend(ctx context.Context, req *CreateFriendRequest) (*CreateFriendResponse, error)
+	// CreateGroup is POST /create_group.
+	CreateGroup(ctx context.Context, req *CreateGroupRequest) (*CreateGroupResponse, error)
+	// DeleteComment is POST /delete_comment/{id}.
+	DeleteComment(ctx context.Context, id int) (*DeleteCommentResponse, error)
+	// DeleteExpense is POST /delete_expense/{id}.
+	DeleteExpense(ctx context.Context, id int) (*DeleteExpenseResponse, error)
+	// DeleteFriend is POST /delete_friend/{id}.
+	DeleteFriend(ctx context.Context, id int) (*DeleteFriendResponse, error)
+	// DeleteGroup is POST /delete_group/{id}.
+	DeleteGroup(ctx context.Context, id int) (*DeleteGroupResponse, error)
+	// GetCategories is GET /get_categories.
+	GetCategories(ctx context.Context) (*GetCategoriesResponse, error)
+	// GetComments is GET /get_comments.
+	GetComments(ctx context.Context, req *GetCommentsRequest) (*GetCommentsResponse, error)
+	// GetCurrencies is GET /get_currencies.
+	GetCurrencies(ctx context.Context) (*GetCurrenciesResponse, error)
+	// GetCurrentUser is GET /get_current_user.
+	GetCurrentUser(ctx context.Context) (*GetCurrentUserResponse, error)
+	// GetExpense is GET /get_expense/{id}.
+	GetExpense(ctx context.Context, id int) (*GetExpenseResponse, error)
+	// GetExpenses is GET /get_expenses.
+	GetExpenses(ctx context.Context, req *GetExpensesRequest) (*GetExpensesResponse, error)
+	// GetFriend is GET /get_friend/{id}.
+	GetFriend(ctx context.Context, id int) (*GetFriendResponse, error)
+	// GetFriends is GET /get_friends.
+	GetFriends(ctx context.Context) (*GetFriendsResponse, error)
+	// GetGroup is GET /get_group/{id}.
+	GetGroup(ctx context.Context, id int) (*GetGroupResponse, error)
+	// GetGroups is GET /get_groups.
+	GetGroups(ctx context.Context) (*GetGroupsResponse, error)
+	// GetUser is GET /get_user/{id}.
+	GetUser(ctx context.Context, id int) (*GetUserResponse, error)
+	// RemoveUserFromGroup is POST /remove_user_from_group.
+	RemoveUserFromGroup(ctx context.Context, req *RemoveUserFromGroupRequest) (*RemoveUserFromGroupResponse, error)
+	// UndeleteExpense is POST /undelete_expense/{id}.
+	UndeleteExpense(ctx context.Context, id int) (*UndeleteExpenseResponse, error)
+	// UndeleteGroup is POST /undelete_group/{id}.
+	UndeleteGroup(ctx context.Context, id int) (*UndeleteGroupResponse, error)
+}
+
+// UnimplementedAPI implements API by returning ErrNotImplemented from every method.
+type UnimplementedAPI struct{}
+
+var _ API = UnimplementedAPI{}
+
+func (UnimplementedAPI) AddUserToGroup(ctx context.Context, req *AddUserToGroupRequest) (*AddUserToGroupResponse, error) {
+	return nil, ErrNotImplemented
+}
+
+func (UnimplementedAPI) CreateComment(ctx context.Context, req *CreateCommentRequest) (*CreateCommentResponse, error) {
+	return nil, ErrNotImplemented
+}
+
+func (UnimplementedAPI) CreateExpense(ctx context.Context, req *CreateExpenseRequest) (*CreateExpenseResponse, error) {
+	return nil, ErrNotImplemented
+}
+
+func (UnimplementedAPI) CreateFriend(ctx context.Context, req *CreateFriendRequest) (*CreateFriendResponse, error) {
+	return nil, ErrNotImplemented
+}
+
+func (UnimplementedAPI) CreateGroup(ctx context.Context, req *CreateGroupRequest) (*CreateGroupResponse, error) {
+	return nil, ErrNotImplemented
+}
+
+func (UnimplementedAPI) DeleteComment(ctx context.Context, id int) (*DeleteCommentResponse, error) {
+	return nil, ErrNotImplemented
+}
+
+func (UnimplementedAPI) DeleteExpense(ctx context.Context, id int) (*DeleteExpenseResponse, error) {
+	return nil, ErrNotImplemented
+}
+
+func (UnimplementedAPI) DeleteFriend(ctx context.Context, id int) (*DeleteFriendResponse, error) {
+	return nil, ErrNotImplemented
+}
+
+func (UnimplementedAPI) DeleteGroup(ctx context.Context, id int) (*DeleteGroupResponse, error) {
+	return nil, ErrNotImplemented
+}
+
+func (UnimplementedAPI) GetCategories(ctx context.Context) (*GetCategoriesResponse, error) {
+	return nil, ErrNotImplemented
+}
+
+func (UnimplementedAPI) GetComments(ctx context.Context, req *GetCommentsRequest) (*GetCommentsResponse, error) {
+	return nil, ErrNotImplemented
+}
+
+func (UnimplementedAPI) GetCurrencies(ctx context.Context) (*GetCurrenciesResponse, error) {
+	return nil, ErrNotImplemented
+}
+
+func (UnimplementedAPI) GetCurrentUser(ctx context.Context) (*GetCurrentUserResponse, error) {
+	return nil, ErrNotImplemented
+}
+
+func (UnimplementedAPI) GetExpense(ctx context.Context, id int) (*GetExpenseResponse, error) {
+	return nil, ErrNotImplemented
+}
+
+func (UnimplementedAPI) GetExpenses(ctx context.Context, req *GetExpensesRequest) (*GetExpensesResponse, error) {
+	return nil, ErrNotImplemented
+}
+
+func (UnimplementedAPI) GetFriend(ctx context.Context, id int) (*GetFriendResponse, error) {
+	return nil, ErrNotImplemented
+}
+
+func (UnimplementedAPI) GetFriends(ctx context.Context) (*GetFriendsResponse, error) {
+	return nil, ErrNotImplemented
+}
+
+func (UnimplementedAPI) GetGroup(ctx context.Context, id int) (*GetGroupResponse, error) {
+	return nil, ErrNotImplemented
+}
+
+func (UnimplementedAPI) GetGroups(ctx context.Context) (*GetGroupsResponse, error) {
+	return nil, ErrNotImplemented
+}
+
+func (UnimplementedAPI) GetUser(ctx context.Context, id int) (*GetUserResponse, error) {
+	return nil, ErrNotImplemented
+}
+
+func (UnimplementedAPI) RemoveUserFromGroup(ctx context.Context, req *RemoveUserFromGroupRequest) (*RemoveUserFromGroupResponse, error) {
+	return nil, ErrNotImplemented
+}
+
+func (UnimplementedAPI) UndeleteExpense(ctx context.Context, id int) (*UndeleteExpenseResponse, error) {
+	return nil, ErrNotImplemented
+}
+
+func (UnimplementedAPI) UndeleteGroup(ctx context.Context, id int) (*UndeleteGroupResponse, error) {
+	return nil, ErrNotImplemented
+}
+
+// Operations describes every operation in the API.
+var Operations = []Operation{
+	{ID: "addUserToGroup", Method: http.MethodPost, Path: "/add_user_to_group"},
+	{ID: "createComment", Method: http.MethodPost, Path: "/create_comment"},
+	{ID: "createExpense", Method: http.MethodPost, Path: "/create_expense"},
+	{ID: "createFriend", Method: http.MethodPost, Path: "/create_friend"},
+	{ID: "createGroup", Method: http.MethodPost, Path: "/create_group"},
+	{ID: "deleteComment", Method: http.MethodPost, Path: "/delete_comment/{id}"},
+	{ID: "deleteExpense", Method: http.MethodPost, Path: "/delete_expense/{id}"},
+	{ID: "deleteFriend", Method: http.MethodPost, Path: "/delete_friend/{id}"},
+	{ID: "deleteGroup", Method: http.MethodPost, Path: "/delete_group/{id}"},
+	{ID: "getCategories", Method: http.MethodGet, Path: "/get_categories"},
+	{ID: "getComments", Method: http.MethodGet, Path: "/get_comments"},
+	{ID: "getCurrencies", Method: http.MethodGet, Path: "/get_currencies"},
+	{ID: "getCurrentUser", Method: http.MethodGet, Path: "/get_current_user"},
+	{ID: "getExpense", Method: http.MethodGet, Path: "/get_expense/{id}"},
+	{ID: "getExpenses", Method: http.MethodGet, Path: "/get_expenses"},
+	{ID: "getFriend", Method: http.MethodGet, Path: "/get_friend/{id}"},
+	{ID: "getFriends", Method: http.MethodGet, Path: "/get_friends"},
+	{ID: "getGroup", Method: http.MethodGet, Path: "/get_group/{id}"},
+	{ID: "getGroups", Method: http.MethodGet, Path: "/get_groups"},
+	{ID: "getUser", Method: http.MethodGet, Path: "/get_user/{id}"},
+	{ID: "removeUserFromGroup", Method: http.MethodPost, Path: "/remove_user_from_group"},
+	{ID: "undeleteExpense", Method: http.MethodPost, Path: "/undelete_expense/{id}"},
+	{ID: "undeleteGroup", Method: http.MethodPost, Path: "/undelete_group/{id}"},
+}
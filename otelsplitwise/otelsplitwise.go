@@ -0,0 +1,139 @@
+// Package otelsplitwise instruments a splitwise.Client with OpenTelemetry tracing.
+//
+// Every API call made by the client is recorded as a span named after the client method,
+// e.g. "GetExpenses" or "CreateGroup":
+//
+//	client := splitwise.NewClient(httpClient, splitwise.WithMiddleware(otelsplitwise.Middleware()))
+//
+// Spans are started from the context passed to the client, and that context is propagated
+// to the underlying HTTPClient so that any HTTP instrumentation is nested beneath them.
+package otelsplitwise
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"strings"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/cwbriones/go-splitwise/otelsplitwise"
+
+// Option configures the tracing middleware.
+type Option func(*config)
+
+type config struct {
+	provider trace.TracerProvider
+}
+
+// WithTracerProvider sets the TracerProvider used to create spans.
+//
+// By default the global TracerProvider is used.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.provider = provider
+	}
+}
+
+// Middleware returns a splitwise.Middleware which records a span for every operation.
+func Middleware(opts ...Option) splitwise.Middleware {
+	cfg := config{
+		provider: otel.GetTracerProvider(),
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	tracer := cfg.provider.Tracer(instrumentationName)
+
+	return func(next splitwise.Handler) splitwise.Handler {
+		return func(ctx context.Context, op *splitwise.Operation) error {
+			ctx, span := tracer.Start(
+				ctx,
+				SpanName(op.Name),
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(requestAttributes(op)...),
+			)
+			defer span.End()
+
+			err := next(ctx, op)
+			if op.StatusCode != 0 {
+				span.SetAttributes(attribute.Int("http.response.status_code", op.StatusCode))
+			}
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+				return err
+			}
+			if count, ok := resultCount(op.Result); ok {
+				span.SetAttributes(attribute.Int("splitwise.result.count", count))
+			}
+			return nil
+		}
+	}
+}
+
+// SpanName converts an operation name such as "get_expenses" into the name of the
+// corresponding client method, "GetExpenses".
+func SpanName(operation string) string {
+	var sb strings.Builder
+	for _, word := range strings.Split(operation, "_") {
+		if word == "" {
+			continue
+		}
+		sb.WriteString(strings.ToUpper(word[:1]))
+		sb.WriteString(word[1:])
+	}
+	return sb.String()
+}
+
+// requestAttributes extracts the IDs referenced by an operation.
+func requestAttributes(op *splitwise.Operation) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{
+		attribute.String("splitwise.operation", op.Name),
+		attribute.String("http.request.method", op.Method),
+	}
+	// Operations such as "get_group/123" carry the ID of the entity named
+	// by the last word of the operation.
+	if i := strings.LastIndexByte(op.Path, '/'); i >= 0 {
+		if id, err := strconv.Atoi(op.Path[i+1:]); err == nil {
+			entity := op.Name[strings.LastIndexByte(op.Name, '_')+1:]
+			attrs = append(attrs, attribute.Int("splitwise."+entity+".id", id))
+		}
+	}
+	for _, key := range []string{"group_id", "expense_id", "user_id", "friend_id"} {
+		for _, values := range []map[string][]string{op.Values, op.Query} {
+			if vs, ok := values[key]; ok && len(vs) > 0 {
+				if id, err := strconv.Atoi(vs[0]); err == nil {
+					entity := strings.TrimSuffix(key, "_id")
+					attrs = append(attrs, attribute.Int("splitwise."+entity+".id", id))
+				}
+			}
+		}
+	}
+	return attrs
+}
+
+// resultCount returns the number of items in a list response such as GetExpenses.
+func resultCount(result interface{}) (int, bool) {
+	v := reflect.ValueOf(result)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return 0, false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return 0, false
+	}
+	for i := 0; i < v.NumField(); i++ {
+		if f := v.Field(i); f.Kind() == reflect.Slice {
+			return f.Len(), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,133 @@
+package otelsplitwise
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type testHTTPClient struct {
+	u      *url.URL
+	client http.Client
+}
+
+func (tc *testHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	req.URL.Host = tc.u.Host
+	req.URL.Scheme = tc.u.Scheme
+	return tc.client.Do(req)
+}
+
+func newTestClient(t *testing.T, status int, body string) (*splitwise.Client, *tracetest.InMemoryExporter) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Add("Content-Type", "application/json")
+		rw.WriteHeader(status)
+		rw.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	u, _ := url.Parse(server.URL)
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	client := splitwise.NewClient(
+		&testHTTPClient{u: u},
+		splitwise.WithMiddleware(Middleware(WithTracerProvider(provider))),
+	)
+	return client, exporter
+}
+
+func attrs(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+	m := make(map[attribute.Key]attribute.Value)
+	for _, kv := range span.Attributes {
+		m[kv.Key] = kv.Value
+	}
+	return m
+}
+
+func TestSpanForListOperation(t *testing.T) {
+	client, exporter := newTestClient(t, 200, `{"expenses": [{"id": 1}, {"id": 2}]}`)
+	if _, err := client.GetExpenses(context.Background(), &splitwise.GetExpensesRequest{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+	span := spans[0]
+	if span.Name != "GetExpenses" {
+		t.Errorf("unexpected span name %q", span.Name)
+	}
+	a := attrs(span)
+	if a["splitwise.result.count"].AsInt64() != 2 {
+		t.Errorf("expected result count 2, got %v", a["splitwise.result.count"])
+	}
+	if a["http.response.status_code"].AsInt64() != 200 {
+		t.Errorf("expected status 200, got %v", a["http.response.status_code"])
+	}
+}
+
+func TestSpanForFailedOperation(t *testing.T) {
+	client, exporter := newTestClient(t, 404, `{}`)
+	_, err := client.GetGroup(context.Background(), 42)
+	if !errors.Is(err, splitwise.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+	span := spans[0]
+	if span.Name != "GetGroup" {
+		t.Errorf("unexpected span name %q", span.Name)
+	}
+	if span.Status.Code != codes.Error {
+		t.Errorf("expected error status, got %v", span.Status)
+	}
+	if id := attrs(span)["splitwise.group.id"].AsInt64(); id != 42 {
+		t.Errorf("expected group id 42, got %d", id)
+	}
+}
+
+func TestSpanPropagatesContext(t *testing.T) {
+	client, exporter := newTestClient(t, 200, `{"expense": {"id": 7}}`)
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "parent")
+	if _, err := client.GetExpense(ctx, 7); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	parent.End()
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+	child := spans[0]
+	if child.Parent.SpanID() != parent.SpanContext().SpanID() {
+		t.Errorf("expected span to be a child of the caller's span")
+	}
+	if id := attrs(child)["splitwise.expense.id"].AsInt64(); id != 7 {
+		t.Errorf("expected expense id 7, got %d", id)
+	}
+}
+
+func TestSpanName(t *testing.T) {
+	cases := map[string]string{
+		"get_expenses":      "GetExpenses",
+		"create_group":      "CreateGroup",
+		"add_user_to_group": "AddUserToGroup",
+		"get_current_user":  "GetCurrentUser",
+	}
+	for in, expected := range cases {
+		if actual := SpanName(in); actual != expected {
+			t.Errorf("SpanName(%q) = %q, expected %q", in, actual, expected)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+// Package promsplitwise exposes Prometheus metrics for a splitwise.Client.
+//
+// A Collector records the latency, status and errors of every API call made by the
+// clients it is registered with:
+//
+//	collector := promsplitwise.NewCollector()
+//	prometheus.MustRegister(collector)
+//	client := splitwise.NewClient(httpClient, promsplitwise.WithMetrics(collector))
+package promsplitwise
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Collector is a prometheus.Collector for splitwise API calls.
+type Collector struct {
+	duration  *prometheus.HistogramVec
+	requests  *prometheus.CounterVec
+	apiErrors *prometheus.CounterVec
+	inFlight  prometheus.Gauge
+}
+
+// Option configures a Collector.
+type Option func(*config)
+
+type config struct {
+	namespace string
+	buckets   []float64
+}
+
+// WithNamespace sets the namespace of all metrics. It defaults to "splitwise".
+func WithNamespace(namespace string) Option {
+	return func(c *config) {
+		c.namespace = namespace
+	}
+}
+
+// WithBuckets sets the buckets of the request duration histogram.
+//
+// It defaults to prometheus.DefBuckets.
+func WithBuckets(buckets []float64) Option {
+	return func(c *config) {
+		c.buckets = buckets
+	}
+}
+
+// NewCollector creates a Collector. It must be registered with a prometheus.Registerer
+// for its metrics to be exported.
+func NewCollector(opts ...Option) *Collector {
+	cfg := config{
+		namespace: "splitwise",
+		buckets:   prometheus.DefBuckets,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &Collector{
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: cfg.namespace,
+			Name:      "request_duration_seconds",
+			Help:      "Latency of splitwise API calls by operation.",
+			Buckets:   cfg.buckets,
+		}, []string{"operation"}),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: cfg.namespace,
+			Name:      "requests_total",
+			Help:      "Number of splitwise API calls by operation and HTTP status.",
+		}, []string{"operation", "status"}),
+		apiErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: cfg.namespace,
+			Name:      "api_errors_total",
+			Help:      "Number of errors reported in splitwise API responses by operation.",
+		}, []string{"operation"}),
+		inFlight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: cfg.namespace,
+			Name:      "requests_in_flight",
+			Help:      "Number of splitwise API calls currently in progress.",
+		}),
+	}
+}
+
+// WithMetrics records the metrics of every API call made by a Client into c.
+func WithMetrics(c *Collector) splitwise.Option {
+	return splitwise.WithMiddleware(c.Middleware())
+}
+
+// Middleware returns the splitwise.Middleware used by WithMetrics.
+func (c *Collector) Middleware() splitwise.Middleware {
+	return func(next splitwise.Handler) splitwise.Handler {
+		return func(ctx context.Context, op *splitwise.Operation) error {
+			c.inFlight.Inc()
+			defer c.inFlight.Dec()
+			start := time.Now()
+			err := next(ctx, op)
+			c.duration.WithLabelValues(op.Name).Observe(time.Since(start).Seconds())
+
+			status := "none"
+			if op.StatusCode != 0 {
+				status = strconv.Itoa(op.StatusCode)
+			}
+			c.requests.WithLabelValues(op.Name, status).Inc()
+
+			var apiErr *splitwise.APIError
+			if errors.As(err, &apiErr) {
+				c.apiErrors.WithLabelValues(op.Name).Inc()
+			}
+			return err
+		}
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.duration.Describe(ch)
+	c.requests.Describe(ch)
+	c.apiErrors.Describe(ch)
+	c.inFlight.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.duration.Collect(ch)
+	c.requests.Collect(ch)
+	c.apiErrors.Collect(ch)
+	c.inFlight.Collect(ch)
+}
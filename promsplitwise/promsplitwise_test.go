@@ -0,0 +1,97 @@
+package promsplitwise
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type testHTTPClient struct {
+	u      *url.URL
+	client http.Client
+}
+
+func (tc *testHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	req.URL.Host = tc.u.Host
+	req.URL.Scheme = tc.u.Scheme
+	return tc.client.Do(req)
+}
+
+func newTestClient(t *testing.T, collector *Collector, status int, body string) *splitwise.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Add("Content-Type", "application/json")
+		rw.WriteHeader(status)
+		rw.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	u, _ := url.Parse(server.URL)
+	return splitwise.NewClient(&testHTTPClient{u: u}, WithMetrics(collector))
+}
+
+func TestCollector(t *testing.T) {
+	collector := NewCollector()
+	registry := prometheus.NewPedanticRegistry()
+	registry.MustRegister(collector)
+
+	ctx := context.Background()
+	ok := newTestClient(t, collector, 200, `{"friends": []}`)
+	for i := 0; i < 2; i++ {
+		if _, err := ok.GetFriends(ctx); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	missing := newTestClient(t, collector, 404, `{}`)
+	missing.GetFriend(ctx, 1)
+	failed := newTestClient(t, collector, 200, `{"errors": {"base": ["invalid"]}}`)
+	if _, err := failed.CreateComment(ctx, 1, "hello"); err == nil {
+		t.Fatalf("expected an api error")
+	}
+
+	expected := `
+# HELP splitwise_requests_total Number of splitwise API calls by operation and HTTP status.
+# TYPE splitwise_requests_total counter
+splitwise_requests_total{operation="create_comment",status="200"} 1
+splitwise_requests_total{operation="get_friend",status="404"} 1
+splitwise_requests_total{operation="get_friends",status="200"} 2
+# HELP splitwise_api_errors_total Number of errors reported in splitwise API responses by operation.
+# TYPE splitwise_api_errors_total counter
+splitwise_api_errors_total{operation="create_comment"} 1
+# HELP splitwise_requests_in_flight Number of splitwise API calls currently in progress.
+# TYPE splitwise_requests_in_flight gauge
+splitwise_requests_in_flight 0
+`
+	err := testutil.GatherAndCompare(
+		registry,
+		strings.NewReader(expected),
+		"splitwise_requests_total",
+		"splitwise_api_errors_total",
+		"splitwise_requests_in_flight",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := testutil.CollectAndCount(collector, "splitwise_request_duration_seconds"); n != 3 {
+		t.Errorf("expected 3 histograms, got %d", n)
+	}
+}
+
+func TestCollectorPanic(t *testing.T) {
+	collector := NewCollector()
+	handler := collector.Middleware()(func(ctx context.Context, op *splitwise.Operation) error {
+		panic("boom")
+	})
+	func() {
+		defer func() { recover() }()
+		handler(context.Background(), &splitwise.Operation{Name: "get_friends"})
+	}()
+	if v := testutil.ToFloat64(collector.inFlight); v != 0 {
+		t.Errorf("expected no calls in flight after a panic, got %v", v)
+	}
+}
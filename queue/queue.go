@@ -0,0 +1,500 @@
+// Package queue records mutating splitwise operations while offline and replays them
+// once the API is reachable again.
+//
+// Operations are queued using the same methods as a splitwise.Client, and persisted
+// to disk immediately so that they survive restarts:
+//
+//	q, err := queue.Open("pending.json", client)
+//	expenseID, err := q.CreateExpense(ctx, req)
+//	_, err = q.CreateComment(ctx, expenseID, "receipt attached")
+//	...
+//	report, err := q.Replay(ctx)
+//
+// Objects created by queued operations are given negative local IDs until they have
+// been replayed. Local IDs may be passed to later queued operations, and are replaced
+// by the ID assigned by the server during replay.
+package queue
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+)
+
+// Status is the state of a queued Entry.
+type Status string
+
+const (
+	// StatusPending entries have not yet been replayed.
+	StatusPending Status = "pending"
+	// StatusDone entries have been replayed successfully.
+	StatusDone Status = "done"
+	// StatusConflict entries were rejected by the server and will not be retried.
+	StatusConflict Status = "conflict"
+)
+
+// Entry is a queued operation.
+type Entry struct {
+	Seq       int        `json:"seq"`
+	Operation string     `json:"operation"`
+	Method    string     `json:"method"`
+	Path      string     `json:"path"`
+	Query     url.Values `json:"query,omitempty"`
+	Values    url.Values `json:"values,omitempty"`
+	QueuedAt  time.Time  `json:"queued_at"`
+
+	Status Status `json:"status"`
+	// ServerID is the ID of the object created by the operation, once replayed.
+	ServerID int `json:"server_id,omitempty"`
+	// Error describes the conflict which prevented the entry from being replayed.
+	Error string `json:"error,omitempty"`
+}
+
+// LocalID is the ID which refers to the object created by the entry until it has
+// been replayed.
+func (e *Entry) LocalID() int {
+	return -e.Seq
+}
+
+// Queue is a durable queue of operations.
+type Queue struct {
+	client *splitwise.Client
+	path   string
+
+	// replayMu serializes calls to Replay, so that an entry is only sent once.
+	replayMu sync.Mutex
+
+	mu      sync.Mutex
+	entries []Entry
+}
+
+// Open loads the queue saved at path, creating it if it does not exist. Queued
+// operations are replayed using client.
+func Open(path string, client *splitwise.Client) (*Queue, error) {
+	q := &Queue{
+		client: client,
+		path:   path,
+	}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return q, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &q.entries); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return q, nil
+}
+
+// Entries returns a copy of every entry in the queue, in order.
+func (q *Queue) Entries() []Entry {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return append([]Entry(nil), q.entries...)
+}
+
+// Pending returns the number of entries which have not yet been replayed.
+func (q *Queue) Pending() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var n int
+	for _, e := range q.entries {
+		if e.Status == StatusPending {
+			n++
+		}
+	}
+	return n
+}
+
+// CreateExpense queues the creation of an expense, returning its local ID.
+//
+// If req.IdempotencyKey is empty, a random key is assigned, so that an expense created
+// by a replay whose response was lost is not created again by the next replay. The
+// search for an existing expense with the key is made when the entry is replayed
+// rather than when it is queued.
+func (q *Queue) CreateExpense(ctx context.Context, req splitwise.CreateExpenseRequest) (int, error) {
+	if req.IdempotencyKey == "" {
+		key, err := newIdempotencyKey()
+		if err != nil {
+			return 0, err
+		}
+		req.IdempotencyKey = key
+	}
+	return q.Enqueue(ctx, func(ctx context.Context, c *splitwise.Client) error {
+		_, err := c.CreateExpense(ctx, req)
+		return err
+	})
+}
+
+func newIdempotencyKey() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b[:]), nil
+}
+
+// DeleteExpense queues the deletion of an expense.
+func (q *Queue) DeleteExpense(ctx context.Context, id int) error {
+	_, err := q.Enqueue(ctx, func(ctx context.Context, c *splitwise.Client) error {
+		return c.DeleteExpense(ctx, id)
+	})
+	return err
+}
+
+// UndeleteExpense queues the restoration of a deleted expense.
+func (q *Queue) UndeleteExpense(ctx context.Context, id int) error {
+	_, err := q.Enqueue(ctx, func(ctx context.Context, c *splitwise.Client) error {
+		return c.UndeleteExpense(ctx, id)
+	})
+	return err
+}
+
+// CreateComment queues a comment on an expense, returning its local ID.
+func (q *Queue) CreateComment(ctx context.Context, expenseID int, content string) (int, error) {
+	return q.Enqueue(ctx, func(ctx context.Context, c *splitwise.Client) error {
+		_, err := c.CreateComment(ctx, expenseID, content)
+		return err
+	})
+}
+
+// CreateGroup queues the creation of a group, returning its local ID.
+func (q *Queue) CreateGroup(ctx context.Context, req splitwise.CreateGroupRequest, user splitwise.UserOption, users ...splitwise.UserOption) (int, error) {
+	return q.Enqueue(ctx, func(ctx context.Context, c *splitwise.Client) error {
+		_, err := c.CreateGroup(ctx, req, user, users...)
+		return err
+	})
+}
+
+// AddUserToGroup queues adding a user to a group.
+func (q *Queue) AddUserToGroup(ctx context.Context, id int, user splitwise.UserOption) error {
+	_, err := q.Enqueue(ctx, func(ctx context.Context, c *splitwise.Client) error {
+		return c.AddUserToGroup(ctx, id, user)
+	})
+	return err
+}
+
+// RemoveUserFromGroup queues removing a user from a group.
+func (q *Queue) RemoveUserFromGroup(ctx context.Context, id int, userID int) error {
+	_, err := q.Enqueue(ctx, func(ctx context.Context, c *splitwise.Client) error {
+		return c.RemoveUserFromGroup(ctx, id, userID)
+	})
+	return err
+}
+
+// errCaptured is returned to the client method once its operation has been recorded.
+var errCaptured = errors.New("operation captured")
+
+// Enqueue records the single operation made by f, returning its local ID.
+//
+// f is called with a client that records operations rather than sending them, so
+// any client method may be queued.
+func (q *Queue) Enqueue(ctx context.Context, f func(context.Context, *splitwise.Client) error) (int, error) {
+	var captured []*splitwise.Operation
+	var reads []string
+	recorder := splitwise.NewClient(nil, splitwise.WithMiddleware(func(next splitwise.Handler) splitwise.Handler {
+		return func(ctx context.Context, op *splitwise.Operation) error {
+			if op.Method == http.MethodGet {
+				// Reads made while recording find nothing. The lookup made by
+				// CreateExpense for an idempotency key is made on replay instead.
+				reads = append(reads, op.Name)
+				return nil
+			}
+			captured = append(captured, op)
+			return errCaptured
+		}
+	}))
+	if err := f(ctx, recorder); err != nil && !errors.Is(err, errCaptured) {
+		return 0, err
+	}
+	if len(captured) == 0 && len(reads) > 0 {
+		return 0, fmt.Errorf("%s does not modify anything and cannot be queued", reads[0])
+	}
+	if len(captured) != 1 {
+		return 0, fmt.Errorf("expected a single operation, got %d", len(captured))
+	}
+	op := captured[0]
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	seq := 1
+	if n := len(q.entries); n > 0 {
+		seq = q.entries[n-1].Seq + 1
+	}
+	q.entries = append(q.entries, Entry{
+		Seq:       seq,
+		Operation: op.Name,
+		Method:    op.Method,
+		Path:      op.Path,
+		Query:     op.Query,
+		Values:    op.Values,
+		QueuedAt:  time.Now(),
+		Status:    StatusPending,
+	})
+	if err := q.save(); err != nil {
+		q.entries = q.entries[:len(q.entries)-1]
+		return 0, err
+	}
+	return -seq, nil
+}
+
+// Report summarizes the result of a replay.
+type Report struct {
+	// Replayed holds the entries which were replayed successfully.
+	Replayed []Entry
+	// Conflicts holds the entries which were rejected by the server.
+	Conflicts []Entry
+	// Remaining is the number of entries still pending.
+	Remaining int
+}
+
+// Replay sends the pending entries in order.
+//
+// Entries which are rejected by the server, e.g. because the group they refer to has
+// since been deleted or the user may not access it, are marked as conflicts and
+// replay continues with the next entry. If the API cannot be reached, or the request
+// is unauthenticated, timed out or rate limited, replay stops and the remaining
+// entries are left pending for the next call.
+//
+// Operations may be queued while a replay is in progress; they are replayed by the
+// same call.
+func (q *Queue) Replay(ctx context.Context) (*Report, error) {
+	q.replayMu.Lock()
+	defer q.replayMu.Unlock()
+
+	report := &Report{}
+	var replayErr error
+	for i := 0; ; i++ {
+		q.mu.Lock()
+		if i == len(q.entries) {
+			q.mu.Unlock()
+			return report, replayErr
+		}
+		e := q.entries[i]
+		if e.Status != StatusPending {
+			q.mu.Unlock()
+			continue
+		}
+		if replayErr != nil {
+			q.mu.Unlock()
+			report.Remaining++
+			continue
+		}
+		op := q.operation(&e)
+		q.mu.Unlock()
+
+		if op != nil {
+			// The queue is not locked while sending, so that it may be read or
+			// appended to meanwhile.
+			if err := q.send(ctx, &e, op); err != nil {
+				replayErr = err
+				report.Remaining++
+				continue
+			}
+		}
+		if e.Status == StatusConflict {
+			report.Conflicts = append(report.Conflicts, e)
+		} else {
+			report.Replayed = append(report.Replayed, e)
+		}
+		q.mu.Lock()
+		q.entries[i] = e
+		err := q.save()
+		q.mu.Unlock()
+		if err != nil {
+			return report, err
+		}
+	}
+}
+
+// replayResult captures the ID of any object created by an operation.
+type replayResult struct {
+	Expense *object            `json:"expense"`
+	Comment *object            `json:"comment"`
+	Group   *object            `json:"group"`
+	Friend  *object            `json:"friend"`
+	Success *bool              `json:"success"`
+	Errors  splitwise.APIError `json:"errors"`
+}
+
+type object struct {
+	ID int `json:"id"`
+}
+
+// operation builds the operation which replays a pending entry, resolving the local
+// IDs it refers to. If they cannot be resolved, the entry is marked as a conflict and
+// nil is returned. The caller must hold q.mu.
+func (q *Queue) operation(e *Entry) *splitwise.Operation {
+	op := &splitwise.Operation{
+		Name:   e.Operation,
+		Method: e.Method,
+		Query:  e.Query,
+	}
+	var err error
+	if op.Path, err = q.resolvePath(e.Path); err == nil {
+		op.Values, err = q.resolveValues(e.Values)
+	}
+	if err != nil {
+		e.Status = StatusConflict
+		e.Error = err.Error()
+		return nil
+	}
+	return op
+}
+
+// send replays the operation of a single entry, updating its status. An error is only
+// returned if the entry should be retried later.
+func (q *Queue) send(ctx context.Context, e *Entry, op *splitwise.Operation) error {
+	if key, ok := idempotencyKey(e); ok {
+		existing, err := q.client.FindExpenseByIdempotencyKey(ctx, key)
+		switch {
+		case err == nil:
+			// An earlier replay created the expense but its outcome was lost.
+			e.Status = StatusDone
+			e.ServerID = existing.ID
+			return nil
+		case !errors.Is(err, splitwise.ErrNotFound):
+			return err
+		}
+	}
+
+	var res replayResult
+	op.Result = &res
+	err := q.client.Execute(ctx, op)
+	var status splitwise.UnexpectedStatus
+	switch {
+	case err == nil:
+	case errors.As(err, &status) && rejected(status.Status):
+		e.Status = StatusConflict
+		e.Error = err.Error()
+		return nil
+	default:
+		return err
+	}
+	if res.Errors.Len() > 0 {
+		e.Status = StatusConflict
+		e.Error = res.Errors.Error()
+		return nil
+	}
+	if res.Success != nil && !*res.Success {
+		e.Status = StatusConflict
+		e.Error = "unsuccessful"
+		return nil
+	}
+	e.Status = StatusDone
+	for _, o := range []*object{res.Expense, res.Comment, res.Group, res.Friend} {
+		if o != nil {
+			e.ServerID = o.ID
+			break
+		}
+	}
+	return nil
+}
+
+// idempotencyKey returns the idempotency key of an entry which creates an expense.
+func idempotencyKey(e *Entry) (string, bool) {
+	if e.Operation != "create_expense" {
+		return "", false
+	}
+	expense := splitwise.Expense{Details: e.Values.Get("details")}
+	return expense.IdempotencyKey()
+}
+
+// rejected reports whether a response with the given status rejects the entry itself.
+// Client errors are permanent, except for those where the request may succeed if
+// replayed later: it was unauthenticated, timed out or rate limited.
+func rejected(status int) bool {
+	switch status {
+	case http.StatusUnauthorized, http.StatusRequestTimeout, http.StatusTooManyRequests:
+		return false
+	}
+	return status >= 400 && status < 500
+}
+
+// resolveID maps a local ID to the server ID of the entry that created it.
+func (q *Queue) resolveID(raw string) (string, error) {
+	id, err := strconv.Atoi(raw)
+	if err != nil || id >= 0 {
+		return raw, nil
+	}
+	for _, e := range q.entries {
+		if e.LocalID() != id {
+			continue
+		}
+		if e.Status != StatusDone || e.ServerID == 0 {
+			return "", fmt.Errorf("depends on entry %d which was not replayed", e.Seq)
+		}
+		return strconv.Itoa(e.ServerID), nil
+	}
+	return "", fmt.Errorf("unknown local id %d", id)
+}
+
+func (q *Queue) resolvePath(p string) (string, error) {
+	i := strings.LastIndexByte(p, '/')
+	if i < 0 {
+		return p, nil
+	}
+	id, err := q.resolveID(p[i+1:])
+	if err != nil {
+		return "", err
+	}
+	return p[:i+1] + id, nil
+}
+
+func (q *Queue) resolveValues(values url.Values) (url.Values, error) {
+	if values == nil {
+		return nil, nil
+	}
+	resolved := make(url.Values, len(values))
+	for key, vs := range values {
+		resolved[key] = append([]string(nil), vs...)
+		if !strings.HasSuffix(key, "_id") {
+			continue
+		}
+		for i, v := range vs {
+			id, err := q.resolveID(v)
+			if err != nil {
+				return nil, err
+			}
+			resolved[key][i] = id
+		}
+	}
+	return resolved, nil
+}
+
+// save writes the queue to disk. The caller must hold q.mu.
+func (q *Queue) save() error {
+	data, err := json.MarshalIndent(q.entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(q.path), filepath.Base(q.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), q.path)
+}
@@ -0,0 +1,295 @@
+package queue
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+)
+
+type testHTTPClient struct {
+	u      *url.URL
+	client http.Client
+}
+
+func (tc *testHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	req.URL.Host = tc.u.Host
+	req.URL.Scheme = tc.u.Scheme
+	return tc.client.Do(req)
+}
+
+func fakeServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		switch r.URL.Path {
+		case "/api/v3.0/get_expenses":
+			rw.Write([]byte(`{"expenses": []}`))
+		case "/api/v3.0/create_expense":
+			if !strings.Contains(r.PostForm.Get("details"), "[idempotency-key:") {
+				t.Errorf("expected an idempotency key to be assigned, got %q", r.PostForm.Get("details"))
+			}
+			rw.Write([]byte(`{"expense": {"id": 100}}`))
+		case "/api/v3.0/create_comment":
+			if r.PostForm.Get("expense_id") != "100" {
+				t.Errorf("expected the local expense id to be resolved, got %q", r.PostForm.Get("expense_id"))
+			}
+			rw.Write([]byte(`{"comment": {"id": 200}}`))
+		case "/api/v3.0/add_user_to_group":
+			rw.Write([]byte(`{"success": false, "errors": {"base": ["Group does not exist"]}}`))
+		case "/api/v3.0/delete_expense/100":
+			rw.Write([]byte(`{"success": true}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestReplay(t *testing.T) {
+	ctx := context.Background()
+	server := fakeServer(t)
+	defer server.Close()
+	u, _ := url.Parse(server.URL)
+	client := splitwise.NewClient(&testHTTPClient{u: u})
+	path := filepath.Join(t.TempDir(), "queue.json")
+
+	q, err := Open(path, client)
+	if err != nil {
+		t.Fatalf("open: %s", err)
+	}
+	expenseID, err := q.CreateExpense(ctx, splitwise.CreateExpenseRequest{
+		Cost:          "10.00",
+		Description:   "coffee",
+		SplitStrategy: splitwise.SplitEqually(5),
+	})
+	if err != nil {
+		t.Fatalf("enqueue: %s", err)
+	}
+	if expenseID >= 0 {
+		t.Fatalf("expected a local id, got %d", expenseID)
+	}
+	if _, err := q.CreateComment(ctx, expenseID, "paid in cash"); err != nil {
+		t.Fatalf("enqueue: %s", err)
+	}
+	if err := q.AddUserToGroup(ctx, 9, splitwise.ExistingUser(1)); err != nil {
+		t.Fatalf("enqueue: %s", err)
+	}
+	if err := q.DeleteExpense(ctx, expenseID); err != nil {
+		t.Fatalf("enqueue: %s", err)
+	}
+	if _, err := q.Enqueue(ctx, func(ctx context.Context, c *splitwise.Client) error {
+		_, err := c.GetGroups(ctx)
+		return err
+	}); err == nil {
+		t.Fatalf("expected reads to be rejected")
+	}
+
+	// The queue survives being reopened.
+	q, err = Open(path, client)
+	if err != nil {
+		t.Fatalf("open: %s", err)
+	}
+	if n := q.Pending(); n != 4 {
+		t.Fatalf("expected 4 pending entries, got %d", n)
+	}
+
+	report, err := q.Replay(ctx)
+	if err != nil {
+		t.Fatalf("replay: %s", err)
+	}
+	if len(report.Replayed) != 3 || report.Remaining != 0 {
+		t.Errorf("unexpected report: %+v", report)
+	}
+	if len(report.Conflicts) != 1 || report.Conflicts[0].Operation != "add_user_to_group" {
+		t.Fatalf("expected add_user_to_group to conflict, got %+v", report.Conflicts)
+	}
+	entries := q.Entries()
+	if entries[0].ServerID != 100 || entries[1].ServerID != 200 {
+		t.Errorf("expected server ids to be recorded, got %+v", entries)
+	}
+	if report, err := q.Replay(ctx); err != nil || len(report.Replayed) != 0 {
+		t.Errorf("expected nothing to be replayed again, got %+v, %v", report, err)
+	}
+}
+
+func TestReplayWhileOffline(t *testing.T) {
+	ctx := context.Background()
+	server := fakeServer(t)
+	u, _ := url.Parse(server.URL)
+	server.Close()
+	client := splitwise.NewClient(&testHTTPClient{u: u})
+
+	q, err := Open(filepath.Join(t.TempDir(), "queue.json"), client)
+	if err != nil {
+		t.Fatalf("open: %s", err)
+	}
+	if _, err := q.CreateComment(ctx, 1, "hello"); err != nil {
+		t.Fatalf("enqueue: %s", err)
+	}
+	report, err := q.Replay(ctx)
+	if err == nil {
+		t.Fatalf("expected replay to fail while offline")
+	}
+	if report.Remaining != 1 || q.Pending() != 1 {
+		t.Errorf("expected the entry to remain pending, got %+v", report)
+	}
+}
+
+func TestReplayRetryableStatus(t *testing.T) {
+	for _, status := range []int{http.StatusUnauthorized, http.StatusRequestTimeout, http.StatusTooManyRequests} {
+		ctx := context.Background()
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			rw.WriteHeader(status)
+		}))
+		u, _ := url.Parse(server.URL)
+		client := splitwise.NewClient(&testHTTPClient{u: u})
+
+		q, err := Open(filepath.Join(t.TempDir(), "queue.json"), client)
+		if err != nil {
+			t.Fatalf("open: %s", err)
+		}
+		if _, err := q.CreateComment(ctx, 1, "hello"); err != nil {
+			t.Fatalf("enqueue: %s", err)
+		}
+		report, err := q.Replay(ctx)
+		server.Close()
+		if err == nil {
+			t.Errorf("HTTP %d: expected replay to fail", status)
+		}
+		if len(report.Conflicts) != 0 || report.Remaining != 1 || q.Pending() != 1 {
+			t.Errorf("HTTP %d: expected the entry to remain pending, got %+v", status, report)
+		}
+	}
+}
+
+func TestReplayRejectedStatus(t *testing.T) {
+	for _, status := range []int{http.StatusForbidden, http.StatusGone} {
+		ctx := context.Background()
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/api/v3.0/create_comment" {
+				rw.Write([]byte(`{"comment": {"id": 200}}`))
+				return
+			}
+			rw.WriteHeader(status)
+		}))
+		u, _ := url.Parse(server.URL)
+		client := splitwise.NewClient(&testHTTPClient{u: u})
+
+		q, err := Open(filepath.Join(t.TempDir(), "queue.json"), client)
+		if err != nil {
+			t.Fatalf("open: %s", err)
+		}
+		if err := q.DeleteExpense(ctx, 1); err != nil {
+			t.Fatalf("enqueue: %s", err)
+		}
+		if _, err := q.CreateComment(ctx, 1, "hello"); err != nil {
+			t.Fatalf("enqueue: %s", err)
+		}
+		report, err := q.Replay(ctx)
+		server.Close()
+		if err != nil {
+			t.Errorf("HTTP %d: replay: %s", status, err)
+		}
+		if len(report.Conflicts) != 1 || len(report.Replayed) != 1 || q.Pending() != 0 {
+			t.Errorf("HTTP %d: expected the entry to conflict without blocking the queue, got %+v", status, report)
+		}
+	}
+}
+
+func TestEnqueueDuringReplay(t *testing.T) {
+	ctx := context.Background()
+	var q *Queue
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			// The queue is not locked while an entry is sent.
+			if _, err := q.CreateComment(ctx, 1, "second"); err != nil {
+				t.Errorf("enqueue: %s", err)
+			}
+		}
+		rw.Write([]byte(`{"comment": {"id": 200}}`))
+	}))
+	defer server.Close()
+	u, _ := url.Parse(server.URL)
+	client := splitwise.NewClient(&testHTTPClient{u: u})
+
+	var err error
+	q, err = Open(filepath.Join(t.TempDir(), "queue.json"), client)
+	if err != nil {
+		t.Fatalf("open: %s", err)
+	}
+	if _, err := q.CreateComment(ctx, 1, "first"); err != nil {
+		t.Fatalf("enqueue: %s", err)
+	}
+	report, err := q.Replay(ctx)
+	if err != nil {
+		t.Fatalf("replay: %s", err)
+	}
+	if len(report.Replayed) != 2 || q.Pending() != 0 {
+		t.Errorf("expected both entries to be replayed, got %+v", report)
+	}
+}
+
+func TestReplayIdempotentCreateExpense(t *testing.T) {
+	ctx := context.Background()
+	var lookups, created int
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		switch r.URL.Path {
+		case "/api/v3.0/get_expenses":
+			lookups++
+			if created == 0 {
+				rw.Write([]byte(`{"expenses": []}`))
+				return
+			}
+			rw.Write([]byte(`{"expenses": [{"id": 100, "details": "[idempotency-key:abc]"}]}`))
+		case "/api/v3.0/create_expense":
+			created++
+			if got := r.PostForm.Get("details"); got != "[idempotency-key:abc]" {
+				t.Errorf("expected the key to be recorded in the details, got %q", got)
+			}
+			// The expense is created, but the response is lost.
+			rw.WriteHeader(http.StatusBadGateway)
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	u, _ := url.Parse(server.URL)
+	client := splitwise.NewClient(&testHTTPClient{u: u})
+
+	q, err := Open(filepath.Join(t.TempDir(), "queue.json"), client)
+	if err != nil {
+		t.Fatalf("open: %s", err)
+	}
+	if _, err := q.CreateExpense(ctx, splitwise.CreateExpenseRequest{
+		Cost:           "10.00",
+		Description:    "coffee",
+		SplitStrategy:  splitwise.SplitEqually(5),
+		IdempotencyKey: "abc",
+	}); err != nil {
+		t.Fatalf("enqueue: %s", err)
+	}
+	if lookups != 0 {
+		t.Errorf("expected no lookup while queueing, got %d", lookups)
+	}
+
+	if _, err := q.Replay(ctx); err == nil {
+		t.Fatal("expected the first replay to fail")
+	}
+	report, err := q.Replay(ctx)
+	if err != nil {
+		t.Fatalf("replay: %s", err)
+	}
+	if created != 1 || lookups != 2 {
+		t.Errorf("expected 1 creation and 2 lookups, got %d and %d", created, lookups)
+	}
+	if len(report.Replayed) != 1 || report.Replayed[0].ServerID != 100 {
+		t.Errorf("expected the existing expense to be found, got %+v", report)
+	}
+}
@@ -1,36 +1,55 @@
 package splitwise
 
 import (
+	"encoding"
 	"fmt"
 	"net/url"
+	"reflect"
 	"strconv"
+	"strings"
+	"time"
 )
 
+// valueWriter builds the body of a request, both as form values and as a JSON object
+// for WithJSONRequests.
 type valueWriter struct {
 	url.Values
+
+	// body holds the same values with their types preserved, and arrays nested rather
+	// than flattened.
+	body map[string]interface{}
 }
 
 type requestWriter interface {
 	Str(key, val string)
 	Int(key string, val int)
+
+	// set writes the value with the given form encoding and JSON value.
+	set(key, text string, val interface{})
 }
 
 func newRequest() valueWriter {
 	return valueWriter{
-		make(url.Values),
+		Values: make(url.Values),
+		body:   make(map[string]interface{}),
 	}
 }
 
+func (r valueWriter) set(key, text string, val interface{}) {
+	r.Values.Set(key, text)
+	r.body[key] = val
+}
+
 func (r valueWriter) Str(key, val string) {
-	r.Values.Set(key, val)
+	r.set(key, val, val)
 }
 
 func (r valueWriter) Bool(key string, val bool) {
-	r.Values.Set(key, strconv.FormatBool(val))
+	r.set(key, strconv.FormatBool(val), val)
 }
 
 func (r valueWriter) Int(key string, val int) {
-	r.Values.Set(key, strconv.Itoa(val))
+	r.set(key, strconv.Itoa(val), val)
 }
 
 func (r valueWriter) Array(key string) *arrayWriter {
@@ -44,18 +63,161 @@ type arrayWriter struct {
 	rw     valueWriter
 	prefix string
 	i      int
+	items  []map[string]interface{}
 }
 
 func (a *arrayWriter) Next() {
 	a.i++
 }
 
+func (a *arrayWriter) set(key, text string, val interface{}) {
+	a.rw.Values.Set(fmt.Sprintf("%s__%d__%s", a.prefix, a.i, key), text)
+	for len(a.items) <= a.i {
+		a.items = append(a.items, make(map[string]interface{}))
+	}
+	a.items[a.i][key] = val
+	a.rw.body[a.prefix] = a.items
+}
+
 func (a *arrayWriter) Str(key string, val string) {
-	key = fmt.Sprintf("%s__%d__%s", a.prefix, a.i, key)
-	a.rw.Str(key, val)
+	a.set(key, val, val)
 }
 
 func (a *arrayWriter) Int(key string, val int) {
-	key = fmt.Sprintf("%s__%d__%s", a.prefix, a.i, key)
-	a.rw.Int(key, val)
+	a.set(key, strconv.Itoa(val), val)
+}
+
+// encodeRequest encodes the exported fields of the struct v, or pointer to one, as form
+// values in vw, so that request types can declare their encoding with tags.
+//
+// Fields are named by their json tag, and skipped if it is "-". Nil pointers are
+// omitted, as are zero values of fields tagged with omitempty. A time.Time is formatted
+// with the layout given by the field's layout tag, or time.RFC3339 by default. Slices
+// of structs are encoded with the "name__N__field" convention of arrayWriter.
+//
+// Fields holding a SplitStrategy or UserOption encode themselves and need no tag.
+//
+// The JSON body of the request is built alongside, with booleans and numbers sent as
+// such and everything else, including times, sent as its form encoding.
+func encodeRequest(vw valueWriter, v interface{}) error {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("cannot encode %s as a request", rv.Type())
+	}
+	return encodeStruct(vw, vw, rv, false)
+}
+
+// encodeStruct encodes the fields of rv into w. The top-level writer vw is needed by
+// SplitStrategy, which may only appear outside of arrays.
+func encodeStruct(vw valueWriter, w requestWriter, rv reflect.Value, nested bool) error {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		fv := rv.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		if (fv.Kind() == reflect.Ptr || fv.Kind() == reflect.Interface) && fv.IsNil() {
+			continue
+		}
+		switch enc := fv.Interface().(type) {
+		case SplitStrategy:
+			if nested {
+				return fmt.Errorf("field %s: a SplitStrategy cannot be nested in an array", field.Name)
+			}
+			enc.prepareRequest(vw)
+			continue
+		case UserOption:
+			enc.prepareRequest(w)
+			continue
+		}
+
+		tag := strings.Split(field.Tag.Get("json"), ",")
+		name := tag[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			return fmt.Errorf("field %s has no json tag", field.Name)
+		}
+		omitempty := len(tag) > 1 && tag[1] == "omitempty"
+		if omitempty && fv.IsZero() {
+			continue
+		}
+		fv = reflect.Indirect(fv)
+
+		if fv.Kind() == reflect.Slice {
+			if nested {
+				return fmt.Errorf("field %s: arrays cannot be nested", field.Name)
+			}
+			arr := vw.Array(name)
+			for j := 0; j < fv.Len(); j++ {
+				elem := reflect.Indirect(fv.Index(j))
+				if elem.Kind() != reflect.Struct {
+					return fmt.Errorf("field %s: cannot encode arrays of %s", field.Name, elem.Type())
+				}
+				if err := encodeStruct(vw, arr, elem, true); err != nil {
+					return err
+				}
+				arr.Next()
+			}
+			continue
+		}
+		text, err := formatValue(fv, field.Tag.Get("layout"))
+		if err != nil {
+			return fmt.Errorf("field %s: %s", field.Name, err)
+		}
+		w.set(name, text, jsonValue(fv, text))
+	}
+	return nil
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func formatValue(v reflect.Value, layout string) (string, error) {
+	if v.Type() == timeType {
+		if layout == "" {
+			layout = time.RFC3339
+		}
+		return v.Interface().(time.Time).Format(layout), nil
+	}
+	if m, ok := v.Interface().(encoding.TextMarshaler); ok {
+		text, err := m.MarshalText()
+		return string(text), err
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), nil
+	}
+	return "", fmt.Errorf("cannot encode %s", v.Type())
+}
+
+// jsonValue returns the value of v to send in a JSON request body, given its form
+// encoding text.
+func jsonValue(v reflect.Value, text string) interface{} {
+	if v.Type() == timeType {
+		return text
+	}
+	if _, ok := v.Interface().(encoding.TextMarshaler); ok {
+		return text
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint()
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	}
+	return text
 }
@@ -0,0 +1,106 @@
+package splitwise
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEncodeRequest(t *testing.T) {
+	date := time.Date(2021, 3, 1, 12, 30, 0, 0, time.UTC)
+	groupID := 10
+	repeat := RepeatMonthly
+	tests := []struct {
+		name     string
+		req      interface{}
+		expected url.Values
+	}{
+		{
+			name: "optional fields and layouts",
+			req: &GetExpensesRequest{
+				GroupID:      &groupID,
+				DatedAfter:   &date,
+				UpdatedAfter: &date,
+			},
+			expected: url.Values{
+				"group_id":      {"10"},
+				"dated_after":   {"2021-03-01"},
+				"updated_after": {"2021-03-01T12:30:00Z"},
+			},
+		},
+		{
+			name: "custom encoders",
+			req: CreateExpenseRequest{
+				Cost:           "10.00",
+				Description:    "Pizza",
+				SplitStrategy:  SplitEqually(groupID),
+				RepeatInterval: &repeat,
+				IdempotencyKey: "ignored",
+			},
+			expected: url.Values{
+				"cost":            {"10.00"},
+				"description":     {"Pizza"},
+				"payment":         {"false"},
+				"group_id":        {"10"},
+				"repeat_interval": {"monthly"},
+			},
+		},
+		{
+			name: "nested arrays",
+			req: struct {
+				Name  string      `json:"name"`
+				Users []UserShare `json:"users"`
+			}{
+				Name: "test",
+				Users: []UserShare{
+					{UserOption: ExistingUser(1), PaidShare: "10.00", OwedShare: "5.00"},
+					{UserOption: NewUser(CreateFriendRequest{FirstName: "Alan", Email: "alan@example.com"}), OwedShare: "5.00"},
+				},
+			},
+			expected: url.Values{
+				"name":                 {"test"},
+				"users__0__user_id":    {"1"},
+				"users__0__paid_share": {"10.00"},
+				"users__0__owed_share": {"5.00"},
+				"users__1__first_name": {"Alan"},
+				"users__1__last_name":  {""},
+				"users__1__email":      {"alan@example.com"},
+				"users__1__paid_share": {""},
+				"users__1__owed_share": {"5.00"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rw := newRequest()
+			if err := encodeRequest(rw, tt.req); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(rw.Values, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, rw.Values)
+			}
+		})
+	}
+}
+
+func TestEncodeRequestErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		req  interface{}
+	}{
+		{"not a struct", "cost"},
+		{"missing tag", struct{ Cost string }{"10.00"}},
+		{"unsupported type", struct {
+			Shares map[string]string `json:"shares"`
+		}{map[string]string{}}},
+		{"nested split", struct {
+			Expenses []CreateExpenseRequest `json:"expenses"`
+		}{[]CreateExpenseRequest{{SplitStrategy: SplitEqually(1)}}}},
+	}
+	for _, tt := range tests {
+		if err := encodeRequest(newRequest(), tt.req); err == nil {
+			t.Errorf("%s: expected an error", tt.name)
+		}
+	}
+}
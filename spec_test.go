@@ -0,0 +1,156 @@
+package splitwise
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/cwbriones/go-splitwise/openapi"
+)
+
+// specModels pairs each hand-written model with the type generated for its schema in
+// openapi/splitwise.json.
+var specModels = []struct {
+	model, schema interface{}
+}{
+	{User{}, openapi.CurrentUser{}},
+	{Picture{}, openapi.Picture{}},
+	{NotificationSet{}, openapi.NotificationSettings{}},
+	{Group{}, openapi.Group{}},
+	{GroupMember{}, openapi.GroupMember{}},
+	{GroupDebt{}, openapi.Debt{}},
+	{Balance{}, openapi.Balance{}},
+	{BalanceByGroup{}, openapi.GroupBalance{}},
+	{Friend{}, openapi.Friend{}},
+	{Category{}, openapi.Category{}},
+	{Subcategory{}, openapi.Subcategory{}},
+	{Currency{}, openapi.Currency{}},
+	{Expense{}, openapi.Expense{}},
+	{ExpenseUser{}, openapi.Share{}},
+	{Repayment{}, openapi.Repayment{}},
+	{Comment{}, openapi.Comment{}},
+}
+
+// knownDifferences lists the known differences between the hand-written models and the
+// description, as "Type.field". Fields are "missing" from the model or "extra" in it.
+//
+// Remove entries as they are fixed. New differences fail TestSpecConsistency.
+var knownDifferences = map[string]string{
+	"Expense.comments":                  "missing",
+	"Expense.comments_count":            "missing",
+	"Expense.created_by":                "missing",
+	"Expense.deleted_by":                "missing",
+	"Expense.email_reminder":            "missing",
+	"Expense.email_reminder_in_advance": "missing",
+	"Expense.next_repeat":               "missing",
+	"Expense.receipt":                   "missing",
+	"Expense.repeat_interval":           "missing",
+	"Expense.repeats":                   "missing",
+	"Expense.transaction_confirmed":     "missing",
+	"Expense.updated_by":                "missing",
+	"Friend.email":                      "missing",
+	"Friend.registration_status":        "missing",
+	"Group.invite_link":                 "missing",
+	"Group.simplified_debts":            "missing",
+	"Group.whiteboard":                  "missing",
+	"GroupMember.members":               "extra",
+	"GroupMember.original_debts":        "extra",
+	"GroupMember.simplify_by_default":   "extra",
+}
+
+// TestSpecConsistency fails when the fields of a hand-written model differ from its
+// schema in the OpenAPI description, other than those in knownDifferences.
+//
+// The description is transcribed from the same documentation examples as the models
+// rather than published by Splitwise, so this only keeps the two consistent as either
+// is edited. It cannot detect changes made to the API. See package openapi.
+func TestSpecConsistency(t *testing.T) {
+	found := make(map[string]string)
+	for _, m := range specModels {
+		model, schema := reflect.TypeOf(m.model), reflect.TypeOf(m.schema)
+		modelFields, schemaFields := jsonFields(model), jsonFields(schema)
+		for name, field := range schemaFields {
+			key := model.Name() + "." + name
+			mf, ok := modelFields[name]
+			if !ok {
+				found[key] = "missing"
+				continue
+			}
+			if mk, sk := jsonKind(mf), jsonKind(field); mk != "" && sk != "" && mk != sk {
+				found[key] = "is a JSON " + mk + " but the schema has a " + sk
+			}
+		}
+		for name := range modelFields {
+			if _, ok := schemaFields[name]; !ok {
+				found[model.Name()+"."+name] = "extra"
+			}
+		}
+	}
+
+	var keys []string
+	for key := range found {
+		keys = append(keys, key)
+	}
+	for key := range knownDifferences {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for i, key := range keys {
+		if i > 0 && keys[i-1] == key {
+			continue
+		}
+		switch want, got := knownDifferences[key], found[key]; {
+		case want == got:
+		case want == "":
+			t.Errorf("%s: %s (fix the model or add it to knownDifferences)", key, got)
+		case got == "":
+			t.Errorf("%s: no longer differs from the description, remove it from knownDifferences", key)
+		default:
+			t.Errorf("%s: %s, want %s", key, got, want)
+		}
+	}
+}
+
+// jsonFields returns the fields of t by their JSON name.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if f.PkgPath != "" || name == "-" || name == "" {
+			continue
+		}
+		fields[name] = f.Type
+	}
+	return fields
+}
+
+// jsonKind returns the kind of JSON value t is decoded from, or "" if it cannot be
+// known without decoding, e.g. for types that implement json.Unmarshaler.
+func jsonKind(t reflect.Type) string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == timeType {
+		return "string"
+	}
+	if ptr := reflect.PtrTo(t); ptr.Implements(jsonUnmarshalerType) && !ptr.Implements(extraFielderType) {
+		return ""
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice:
+		return "array"
+	case reflect.Struct, reflect.Map:
+		return "object"
+	}
+	return ""
+}
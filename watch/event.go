@@ -0,0 +1,130 @@
+package watch
+
+import (
+	"reflect"
+	"strings"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+)
+
+// Event is a change observed by a Watcher.
+//
+// Changes to expenses are reported as ExpenseCreated, ExpenseUpdated, ExpenseDeleted
+// or ExpenseRestored. If enabled, changes to groups and friends are reported as
+// GroupCreated, GroupUpdated, GroupDeleted, FriendCreated, FriendUpdated or
+// FriendDeleted.
+type Event interface {
+	// Type is the name of the event, e.g. "expense.created".
+	Type() string
+	// ObjectID is the ID of the expense, group or friend that changed.
+	ObjectID() int
+}
+
+// ExpenseCreated is emitted for an expense that has not been seen before.
+type ExpenseCreated struct {
+	Expense splitwise.Expense `json:"expense"`
+}
+
+// ExpenseUpdated is emitted when a known expense is modified.
+type ExpenseUpdated struct {
+	Before  splitwise.Expense `json:"before"`
+	After   splitwise.Expense `json:"after"`
+	Changes []FieldChange     `json:"changes"`
+}
+
+// ExpenseDeleted is emitted when a known expense is deleted.
+type ExpenseDeleted struct {
+	Expense splitwise.Expense `json:"expense"`
+}
+
+// ExpenseRestored is emitted when a deleted expense is undeleted.
+type ExpenseRestored struct {
+	Expense splitwise.Expense `json:"expense"`
+}
+
+// GroupCreated is emitted for a group that has not been seen before.
+type GroupCreated struct {
+	Group splitwise.Group `json:"group"`
+}
+
+// GroupUpdated is emitted when a known group is modified, including its balances.
+type GroupUpdated struct {
+	Before  splitwise.Group `json:"before"`
+	After   splitwise.Group `json:"after"`
+	Changes []FieldChange   `json:"changes"`
+}
+
+// GroupDeleted is emitted when a known group is no longer part of the account.
+type GroupDeleted struct {
+	Group splitwise.Group `json:"group"`
+}
+
+// FriendCreated is emitted for a friend that has not been seen before.
+type FriendCreated struct {
+	Friend splitwise.Friend `json:"friend"`
+}
+
+// FriendUpdated is emitted when a known friend is modified, including their balances.
+type FriendUpdated struct {
+	Before  splitwise.Friend `json:"before"`
+	After   splitwise.Friend `json:"after"`
+	Changes []FieldChange    `json:"changes"`
+}
+
+// FriendDeleted is emitted when a known friend is no longer part of the account.
+type FriendDeleted struct {
+	Friend splitwise.Friend `json:"friend"`
+}
+
+func (ExpenseCreated) Type() string  { return "expense.created" }
+func (ExpenseUpdated) Type() string  { return "expense.updated" }
+func (ExpenseDeleted) Type() string  { return "expense.deleted" }
+func (ExpenseRestored) Type() string { return "expense.restored" }
+func (GroupCreated) Type() string    { return "group.created" }
+func (GroupUpdated) Type() string    { return "group.updated" }
+func (GroupDeleted) Type() string    { return "group.deleted" }
+func (FriendCreated) Type() string   { return "friend.created" }
+func (FriendUpdated) Type() string   { return "friend.updated" }
+func (FriendDeleted) Type() string   { return "friend.deleted" }
+
+func (e ExpenseCreated) ObjectID() int  { return e.Expense.ID }
+func (e ExpenseUpdated) ObjectID() int  { return e.After.ID }
+func (e ExpenseDeleted) ObjectID() int  { return e.Expense.ID }
+func (e ExpenseRestored) ObjectID() int { return e.Expense.ID }
+func (e GroupCreated) ObjectID() int    { return e.Group.ID }
+func (e GroupUpdated) ObjectID() int    { return e.After.ID }
+func (e GroupDeleted) ObjectID() int    { return e.Group.ID }
+func (e FriendCreated) ObjectID() int   { return e.Friend.ID }
+func (e FriendUpdated) ObjectID() int   { return e.After.ID }
+func (e FriendDeleted) ObjectID() int   { return e.Friend.ID }
+
+// FieldChange is the change to a single field of an object.
+type FieldChange struct {
+	// Field is the JSON name of the field, e.g. "description".
+	Field string      `json:"field"`
+	Old   interface{} `json:"old"`
+	New   interface{} `json:"new"`
+}
+
+// Diff returns the fields that differ between two versions of the same expense,
+// group or friend.
+//
+// Bookkeeping fields such as updated_at are ignored.
+func Diff(before, after interface{}) []FieldChange {
+	var changes []FieldChange
+	bv := reflect.ValueOf(before)
+	av := reflect.ValueOf(after)
+	t := bv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" || name == "updated_at" {
+			continue
+		}
+		old := bv.Field(i).Interface()
+		new := av.Field(i).Interface()
+		if !reflect.DeepEqual(old, new) {
+			changes = append(changes, FieldChange{Field: name, Old: old, New: new})
+		}
+	}
+	return changes
+}
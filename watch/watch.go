@@ -0,0 +1,297 @@
+// Package watch emits events as the expenses of an account change.
+//
+// The splitwise API has no webhooks, so a Watcher polls GetExpenses for expenses
+// updated since the last poll and compares them with the versions it has already
+// seen:
+//
+//	w := watch.New(client, mirror.NewFileStore("watch.json"))
+//	events := make(chan watch.Event)
+//	go w.Run(ctx, events)
+//	for event := range events {
+//		switch e := event.(type) {
+//		case watch.ExpenseCreated:
+//			...
+//		}
+//	}
+//
+// The state of the Watcher is saved to a mirror.Store after every poll, so a restarted
+// Watcher resumes where it left off. With RunFunc, the state is only saved once every
+// event of the poll has been handled.
+package watch
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+	"github.com/cwbriones/go-splitwise/mirror"
+)
+
+const (
+	defaultInterval = time.Minute
+	defaultPageSize = 100
+)
+
+// Watcher polls an account for changes to its expenses.
+type Watcher struct {
+	client *splitwise.Client
+	store  mirror.Store
+
+	interval      time.Duration
+	pageSize      int
+	initialEvents bool
+	groups        bool
+	friends       bool
+	onError       func(error)
+}
+
+// Option configures a Watcher.
+type Option func(*Watcher)
+
+// WithInterval sets how often the Watcher polls for changes. It defaults to a minute.
+func WithInterval(d time.Duration) Option {
+	return func(w *Watcher) {
+		w.interval = d
+	}
+}
+
+// WithPageSize sets the number of expenses requested per page.
+func WithPageSize(n int) Option {
+	return func(w *Watcher) {
+		w.pageSize = n
+	}
+}
+
+// WithInitialEvents emits an ExpenseCreated for every existing expense on the first
+// poll of a Watcher with no saved state.
+//
+// By default the first poll only records the existing expenses.
+func WithInitialEvents() Option {
+	return func(w *Watcher) {
+		w.initialEvents = true
+	}
+}
+
+// WithGroups enables events for changes to groups.
+func WithGroups() Option {
+	return func(w *Watcher) {
+		w.groups = true
+	}
+}
+
+// WithFriends enables events for changes to friends.
+func WithFriends() Option {
+	return func(w *Watcher) {
+		w.friends = true
+	}
+}
+
+// WithErrorHandler makes Run and RunFunc report failed polls to f and continue, rather than
+// returning the error.
+func WithErrorHandler(f func(error)) Option {
+	return func(w *Watcher) {
+		w.onError = f
+	}
+}
+
+// New creates a Watcher which saves its state to store.
+func New(client *splitwise.Client, store mirror.Store, opts ...Option) *Watcher {
+	w := &Watcher{
+		client:   client,
+		store:    store,
+		interval: defaultInterval,
+		pageSize: defaultPageSize,
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+// Handler handles a single event.
+//
+// If it returns an error the poll stops before its state is saved, so the event is
+// sent again by the next poll.
+type Handler func(ctx context.Context, event Event) error
+
+// Run polls for changes until ctx is cancelled, sending them to events.
+//
+// An event is treated as handled once it has been received from events, so events
+// which have not finished being processed when the process exits are lost. Use
+// RunFunc to only advance past events once they have been handled.
+//
+// Run does not close events.
+func (w *Watcher) Run(ctx context.Context, events chan<- Event) error {
+	return w.RunFunc(ctx, sendTo(events))
+}
+
+// RunFunc polls for changes until ctx is cancelled, calling handle for each of them.
+func (w *Watcher) RunFunc(ctx context.Context, handle Handler) error {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		if err := w.PollFunc(ctx, handle); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if w.onError == nil {
+				return err
+			}
+			w.onError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll fetches changes once, sending an event for each of them.
+//
+// The state is saved once all events have been sent. If Poll fails part way through,
+// the events of that poll may be sent again by the next one.
+func (w *Watcher) Poll(ctx context.Context, events chan<- Event) error {
+	return w.PollFunc(ctx, sendTo(events))
+}
+
+// PollFunc fetches changes once, calling handle for each of them in turn.
+//
+// The state is saved once every event has been handled. If PollFunc fails part way
+// through, the events of that poll may be handled again by the next one.
+func (w *Watcher) PollFunc(ctx context.Context, handle Handler) error {
+	state, err := w.store.Load(ctx)
+	if err != nil {
+		return fmt.Errorf("load: %w", err)
+	}
+	emit := w.initialEvents || !state.LastSync.IsZero()
+	send := func(event Event) error {
+		if event == nil || !emit {
+			return nil
+		}
+		return handle(ctx, event)
+	}
+
+	req := &splitwise.GetExpensesRequest{
+		Limit: w.pageSize,
+	}
+	if !state.Cursor.IsZero() {
+		cursor := state.Cursor
+		req.UpdatedAfter = &cursor
+	}
+	expenses, err := w.client.Expenses(ctx, req).All()
+	if err != nil {
+		return fmt.Errorf("get expenses: %w", err)
+	}
+	for _, expense := range expenses {
+		if expense.UpdatedAt.After(state.Cursor) {
+			state.Cursor = expense.UpdatedAt
+		}
+		if err := send(apply(state, expense)); err != nil {
+			return err
+		}
+	}
+
+	if w.groups {
+		groups, err := w.client.GetGroups(ctx)
+		if err != nil {
+			return fmt.Errorf("get groups: %w", err)
+		}
+		seen := make(map[int]splitwise.Group, len(groups))
+		for _, group := range groups {
+			seen[group.ID] = group
+			var event Event
+			if previous, ok := state.Groups[group.ID]; !ok {
+				event = GroupCreated{Group: group}
+			} else if changes := Diff(previous, group); len(changes) > 0 {
+				event = GroupUpdated{Before: previous, After: group, Changes: changes}
+			}
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+		for id, group := range state.Groups {
+			if _, ok := seen[id]; !ok {
+				if err := send(GroupDeleted{Group: group}); err != nil {
+					return err
+				}
+			}
+		}
+		state.Groups = seen
+	}
+
+	if w.friends {
+		friends, err := w.client.GetFriends(ctx)
+		if err != nil {
+			return fmt.Errorf("get friends: %w", err)
+		}
+		seen := make(map[int]splitwise.Friend, len(friends))
+		for _, friend := range friends {
+			seen[friend.ID] = friend
+			var event Event
+			if previous, ok := state.Friends[friend.ID]; !ok {
+				event = FriendCreated{Friend: friend}
+			} else if changes := Diff(previous, friend); len(changes) > 0 {
+				event = FriendUpdated{Before: previous, After: friend, Changes: changes}
+			}
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+		for id, friend := range state.Friends {
+			if _, ok := seen[id]; !ok {
+				if err := send(FriendDeleted{Friend: friend}); err != nil {
+					return err
+				}
+			}
+		}
+		state.Friends = seen
+	}
+
+	state.LastSync = time.Now()
+	if err := w.store.Save(ctx, state); err != nil {
+		return fmt.Errorf("save: %w", err)
+	}
+	return nil
+}
+
+// sendTo returns a Handler which sends each event to events.
+func sendTo(events chan<- Event) Handler {
+	return func(ctx context.Context, event Event) error {
+		select {
+		case events <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// apply records expense in state, returning the resulting event if any.
+func apply(state *mirror.State, expense splitwise.Expense) Event {
+	previous, known := state.Expenses[expense.ID]
+	_, wasDeleted := state.Deleted[expense.ID]
+
+	if expense.DeletedAt != nil {
+		delete(state.Expenses, expense.ID)
+		state.Deleted[expense.ID] = *expense.DeletedAt
+		if known {
+			return ExpenseDeleted{Expense: expense}
+		}
+		return nil
+	}
+	state.Expenses[expense.ID] = expense
+	switch {
+	case wasDeleted:
+		delete(state.Deleted, expense.ID)
+		return ExpenseRestored{Expense: expense}
+	case !known:
+		return ExpenseCreated{Expense: expense}
+	}
+	changes := Diff(previous, expense)
+	if len(changes) == 0 {
+		return nil
+	}
+	return ExpenseUpdated{Before: previous, After: expense, Changes: changes}
+}
@@ -0,0 +1,189 @@
+package watch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"testing"
+	"time"
+
+	splitwise "github.com/cwbriones/go-splitwise"
+	"github.com/cwbriones/go-splitwise/mirror"
+)
+
+type testHTTPClient struct {
+	u      *url.URL
+	client http.Client
+}
+
+func (tc *testHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	req.URL.Host = tc.u.Host
+	req.URL.Scheme = tc.u.Scheme
+	return tc.client.Do(req)
+}
+
+// fakeAccount serves the expenses it holds, honouring updated_after.
+type fakeAccount struct {
+	expenses map[int]splitwise.Expense
+	groups   []splitwise.Group
+}
+
+func (fa *fakeAccount) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/api/v3.0/get_groups" {
+		json.NewEncoder(rw).Encode(map[string]interface{}{"groups": fa.groups})
+		return
+	}
+	after, _ := time.Parse(time.RFC3339, r.URL.Query().Get("updated_after"))
+	var matched []splitwise.Expense
+	for _, e := range fa.expenses {
+		if e.UpdatedAt.After(after) {
+			matched = append(matched, e)
+		}
+	}
+	json.NewEncoder(rw).Encode(map[string]interface{}{"expenses": matched})
+}
+
+func poll(t *testing.T, w *Watcher) []Event {
+	events := make(chan Event, 10)
+	if err := w.Poll(context.Background(), events); err != nil {
+		t.Fatalf("poll: %s", err)
+	}
+	close(events)
+	var all []Event
+	for e := range events {
+		all = append(all, e)
+	}
+	return all
+}
+
+func TestWatcher(t *testing.T) {
+	t0 := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	account := &fakeAccount{expenses: map[int]splitwise.Expense{
+		1: {ID: 1, Description: "rent", Cost: "100.0", UpdatedAt: t0},
+		2: {ID: 2, Description: "groceries", Cost: "20.0", UpdatedAt: t0},
+	}}
+	server := httptest.NewServer(account)
+	defer server.Close()
+	u, _ := url.Parse(server.URL)
+	client := splitwise.NewClient(&testHTTPClient{u: u})
+	store := mirror.NewFileStore(filepath.Join(t.TempDir(), "watch.json"))
+
+	if events := poll(t, New(client, store)); len(events) != 0 {
+		t.Fatalf("expected the first poll to only record existing expenses, got %v", events)
+	}
+
+	deletedAt := t0.Add(2 * time.Minute)
+	account.expenses[1] = splitwise.Expense{ID: 1, Description: "rent", Cost: "120.0", UpdatedAt: t0.Add(time.Minute)}
+	account.expenses[2] = splitwise.Expense{ID: 2, Description: "groceries", Cost: "20.0", UpdatedAt: deletedAt, DeletedAt: &deletedAt}
+	account.expenses[3] = splitwise.Expense{ID: 3, Description: "dinner", Cost: "30.0", UpdatedAt: t0.Add(time.Minute)}
+
+	// A new Watcher on the same store resumes from the saved state.
+	events := poll(t, New(client, store))
+	byID := make(map[int]Event)
+	for _, e := range events {
+		byID[e.ObjectID()] = e
+	}
+	if len(byID) != 3 {
+		t.Fatalf("expected 3 events, got %v", events)
+	}
+	updated, ok := byID[1].(ExpenseUpdated)
+	if !ok {
+		t.Fatalf("expected ExpenseUpdated, got %T", byID[1])
+	}
+	if len(updated.Changes) != 1 || updated.Changes[0].Field != "cost" || updated.Changes[0].New != "120.0" {
+		t.Errorf("unexpected changes: %+v", updated.Changes)
+	}
+	if _, ok := byID[2].(ExpenseDeleted); !ok {
+		t.Errorf("expected ExpenseDeleted, got %T", byID[2])
+	}
+	if _, ok := byID[3].(ExpenseCreated); !ok {
+		t.Errorf("expected ExpenseCreated, got %T", byID[3])
+	}
+
+	if events := poll(t, New(client, store)); len(events) != 0 {
+		t.Fatalf("expected no events to be replayed, got %v", events)
+	}
+
+	account.expenses[2] = splitwise.Expense{ID: 2, Description: "groceries", Cost: "20.0", UpdatedAt: t0.Add(3 * time.Minute)}
+	events = poll(t, New(client, store))
+	if len(events) != 1 {
+		t.Fatalf("expected 1 event, got %v", events)
+	}
+	if _, ok := events[0].(ExpenseRestored); !ok {
+		t.Errorf("expected ExpenseRestored, got %T", events[0])
+	}
+}
+
+func TestWatcherGroups(t *testing.T) {
+	account := &fakeAccount{groups: []splitwise.Group{
+		{ID: 1, Name: "House"},
+		{ID: 2, Name: "Trip"},
+	}}
+	server := httptest.NewServer(account)
+	defer server.Close()
+	u, _ := url.Parse(server.URL)
+	client := splitwise.NewClient(&testHTTPClient{u: u})
+	store := mirror.NewFileStore(filepath.Join(t.TempDir(), "watch.json"))
+	w := New(client, store, WithGroups(), WithInitialEvents())
+
+	if events := poll(t, w); len(events) != 2 {
+		t.Fatalf("expected 2 events, got %v", events)
+	}
+
+	account.groups = []splitwise.Group{{ID: 1, Name: "Apartment"}}
+	events := poll(t, w)
+	types := make(map[string]int)
+	for _, e := range events {
+		types[e.Type()] = e.ObjectID()
+	}
+	if len(events) != 2 || types["group.updated"] != 1 || types["group.deleted"] != 2 {
+		t.Fatalf("unexpected events: %v", events)
+	}
+}
+
+func TestPollFuncRedeliversUnhandledEvents(t *testing.T) {
+	t0 := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	account := &fakeAccount{expenses: map[int]splitwise.Expense{
+		1: {ID: 1, Description: "rent", UpdatedAt: t0},
+		2: {ID: 2, Description: "groceries", UpdatedAt: t0.Add(time.Minute)},
+	}}
+	server := httptest.NewServer(account)
+	defer server.Close()
+	u, _ := url.Parse(server.URL)
+	client := splitwise.NewClient(&testHTTPClient{u: u})
+	store := mirror.NewFileStore(filepath.Join(t.TempDir(), "watch.json"))
+	w := New(client, store, WithInitialEvents())
+
+	failed := errors.New("delivery failed")
+	var handled []int
+	err := w.PollFunc(context.Background(), func(ctx context.Context, event Event) error {
+		if len(handled) == 1 {
+			return failed
+		}
+		handled = append(handled, event.ObjectID())
+		return nil
+	})
+	if !errors.Is(err, failed) {
+		t.Fatalf("expected the handler error, got %v", err)
+	}
+
+	// Nothing was saved, so the next poll sees both expenses again.
+	handled = nil
+	err = w.PollFunc(context.Background(), func(ctx context.Context, event Event) error {
+		handled = append(handled, event.ObjectID())
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("poll: %s", err)
+	}
+	if len(handled) != 2 {
+		t.Errorf("expected both events to be handled again, got %v", handled)
+	}
+	if events := poll(t, w); len(events) != 0 {
+		t.Errorf("expected no events once handled, got %v", events)
+	}
+}